@@ -6,8 +6,10 @@
 //   - BySize: pure rune-count splitting with no language awareness.
 //   - BySentence: sentence-boundary aware splitting via the tokenizer package.
 //   - Recursive: hierarchical splitting (paragraph > sentence > word > rune)
-//     with greedy merge-back. This is the default used by the Chunks convenience
-//     function.
+//     with greedy merge-back. Paragraphs are the blocks of
+//     tokenizer.DocumentTokens: blank-line separated text, Markdown
+//     headings, list items, code blocks, and tables. This is the default
+//     used by the Chunks convenience function.
 //
 // Two API layers:
 //
@@ -22,8 +24,10 @@
 // Known limitations (v1.0):
 //
 //   - BySentence inherits the tokenizer's sentence-boundary limitations:
-//     no quote/parenthesis nesting, no single-letter abbreviation handling.
-//   - Recursive paragraph splitting handles "\n\n" only, not "\r\n\r\n".
+//     quotes and brackets are tracked only within a block, an unclosed
+//     quote joins up to four sentences before it is taken for a stray one,
+//     and single-letter abbreviations (m., s., d.) are not in the built-in
+//     abbreviation list.
 //   - The size parameter is a target for BySentence, not a hard cap.
 //     A single sentence exceeding size is emitted as-is.
 package chunker
@@ -197,6 +197,7 @@ func TestRecursive(t *testing.T) {
 
 		{"short text fits", "Salam, dünya!", 100, 0, 1},
 		{"paragraph split", "Birinci paraqraf.\n\nİkinci paraqraf.", 20, 0, 2},
+		{"crlf paragraph split", "Birinci paraqraf.\r\n\r\nİkinci paraqraf.", 20, 0, 2},
 
 		{"sentence fallback within paragraph",
 			"Birinci cümlə. İkinci cümlə. Üçüncü cümlə.",
@@ -247,6 +248,25 @@ func TestRecursiveParagraphThenSentence(t *testing.T) {
 	}
 }
 
+func TestRecursiveMarkdownBlocks(t *testing.T) {
+	input := "# Giriş\nBu sənəd qaydaları izah edir.\n- birinci tələb\n- ikinci tələb"
+	chunks := Recursive(input, 40, 0)
+	verifyInvariants(t, input, chunks)
+
+	want := []string{
+		"# Giriş\nBu sənəd qaydaları izah edir.\n",
+		"- birinci tələb\n- ikinci tələb",
+	}
+	if len(chunks) != len(want) {
+		t.Fatalf("expected %d chunks, got %d: %v", len(want), len(chunks), chunks)
+	}
+	for i, c := range chunks {
+		if c.Text != want[i] {
+			t.Errorf("chunk %d = %q, want %q", i, c.Text, want[i])
+		}
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Chunks (convenience)
 // ---------------------------------------------------------------------------
@@ -1,16 +1,13 @@
 package chunker
 
 import (
-	"strings"
 	"unicode/utf8"
 
 	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
 )
 
-// paragraphSeparator is the delimiter used for paragraph-level splitting.
-const paragraphSeparator = "\n\n"
-
-// Recursive splits text hierarchically: paragraph > sentence > word > rune.
+// Recursive splits text hierarchically: paragraph > sentence > word > rune,
+// where paragraphs are the blocks of tokenizer.DocumentTokens.
 // After splitting, adjacent small pieces are greedily merged back up to
 // size runes. Overlap is applied as rune-count overlap between merged chunks.
 //
@@ -69,7 +66,7 @@ func splitFragment(text string, frag fragment, size int, level splitLevel) []fra
 
 	switch level {
 	case levelParagraph:
-		parts = splitByParagraph(frag, fragText)
+		parts = splitByTokens(frag, tokenizer.DocumentTokens(fragText))
 	case levelSentence:
 		parts = splitByTokens(frag, tokenizer.SentenceTokens(fragText))
 	case levelWord:
@@ -98,29 +95,8 @@ func splitFragment(text string, frag fragment, size int, level splitLevel) []fra
 	return result
 }
 
-// splitByParagraph splits a fragment on "\n\n" boundaries.
-// The separator is attached to the preceding segment to preserve byte coverage.
-func splitByParagraph(frag fragment, fragText string) []fragment {
-	sep := paragraphSeparator
-	var result []fragment
-	pos := 0
-	for {
-		idx := strings.Index(fragText[pos:], sep)
-		if idx < 0 {
-			break
-		}
-		end := pos + idx + len(sep)
-		result = append(result, fragment{start: frag.start + pos, end: frag.start + end})
-		pos = end
-	}
-	if pos < len(fragText) {
-		result = append(result, fragment{start: frag.start + pos, end: frag.end})
-	}
-	return result
-}
-
 // splitByTokens splits a fragment using the given tokenizer function.
-// Used for both sentence and word level splitting.
+// Used for block, sentence, and word level splitting.
 func splitByTokens(frag fragment, tokens []tokenizer.Token) []fragment {
 	if len(tokens) <= 1 {
 		return []fragment{frag}
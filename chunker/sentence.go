@@ -8,7 +8,7 @@ import (
 
 // BySentence groups sentences into chunks up to size runes.
 // Sentences are detected via tokenizer.SentenceTokens, which handles
-// Azerbaijani abbreviations and double-newline paragraph breaks.
+// Azerbaijani abbreviations and blank-line paragraph breaks.
 //
 // Overlap re-includes whole trailing sentences from the previous chunk.
 // When the last sentence of the previous chunk exceeds the overlap budget,
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Automaton format (data/dict.dawg), read by morph/dawg.go:
+//
+//	magic   [4]byte  "AZDW"
+//	root    uint32   index of the root node's first edge
+//	edges   []uint32 little-endian
+//
+// A node is a run of edges sorted by label; the last edge of a run has
+// dawgLastBit set. An edge packs its label byte in bits 0-7 and its
+// target node (the index of the node's first edge) above dawgTargetShift.
+// A lemma ends where its node has an edge labelled 0, whose target field
+// holds the POS byte instead of a node index.
+const (
+	dawgMagic       = "AZDW"
+	dawgHeaderLen   = 8
+	dawgLastBit     = 1 << 8
+	dawgTargetShift = 9
+	dawgMaxTarget   = 1<<(32-dawgTargetShift) - 1
+)
+
+// trieNode is a node of the uncompressed trie built from the lemma list.
+type trieNode struct {
+	pos      byte // POS byte if a lemma ends here, else 0
+	labels   []byte
+	children []*trieNode
+	id       int // index of the equivalent minimal node, set by minimize
+}
+
+// buildAutomaton encodes lines (<POS byte><lemma>, sorted by lemma) as a
+// minimal acyclic automaton. Only the first POS of a repeated lemma is
+// kept, matching the lookup semantics of the text dictionary.
+func buildAutomaton(lines []string) ([]byte, error) {
+	root := &trieNode{}
+	for _, l := range lines {
+		if len(l) < 2 {
+			continue
+		}
+		if strings.IndexByte(l[1:], 0) >= 0 {
+			return nil, fmt.Errorf("lemma %q contains a NUL byte", l[1:])
+		}
+		n := root
+		for i := 1; i < len(l); i++ {
+			n = n.child(l[i])
+		}
+		if n.pos == 0 {
+			n.pos = l[0]
+		}
+	}
+
+	// Minimize bottom-up: nodes with the same POS and the same labelled
+	// edges to already minimized nodes are merged. Unique nodes are
+	// numbered in post-order, so children always precede their parents.
+	register := make(map[string]int)
+	var unique []*trieNode
+	var minimize func(n *trieNode)
+	minimize = func(n *trieNode) {
+		var sig strings.Builder
+		sig.WriteByte(n.pos)
+		for i, c := range n.children {
+			minimize(c)
+			sig.WriteByte(n.labels[i])
+			sig.WriteString(strconv.Itoa(c.id))
+			sig.WriteByte(',')
+		}
+		key := sig.String()
+		if id, ok := register[key]; ok {
+			n.id = id
+			return
+		}
+		n.id = len(unique)
+		register[key] = n.id
+		unique = append(unique, n)
+	}
+	minimize(root)
+	if root.pos == 0 && len(root.children) == 0 {
+		return nil, fmt.Errorf("no lemmas")
+	}
+
+	// Lay out the edge runs in post-order and record where each starts.
+	start := make([]int, len(unique))
+	total := 0
+	for i, n := range unique {
+		start[i] = total
+		total += len(n.children)
+		if n.pos != 0 {
+			total++
+		}
+	}
+	if total > dawgMaxTarget {
+		return nil, fmt.Errorf("automaton has %d edges, format allows %d", total, dawgMaxTarget)
+	}
+
+	out := make([]byte, dawgHeaderLen, dawgHeaderLen+4*total)
+	copy(out, dawgMagic)
+	binary.LittleEndian.PutUint32(out[4:], uint32(start[root.id]))
+	for _, n := range unique {
+		var edges []uint32
+		if n.pos != 0 {
+			edges = append(edges, uint32(n.pos)<<dawgTargetShift)
+		}
+		for i, c := range n.children {
+			edges = append(edges, uint32(start[c.id])<<dawgTargetShift|uint32(n.labels[i]))
+		}
+		edges[len(edges)-1] |= dawgLastBit
+		for _, e := range edges {
+			out = binary.LittleEndian.AppendUint32(out, e)
+		}
+	}
+	return out, nil
+}
+
+// child returns the child of n on label c, creating it if necessary.
+// Children are kept sorted by label.
+func (n *trieNode) child(c byte) *trieNode {
+	i := sort.Search(len(n.labels), func(i int) bool { return n.labels[i] >= c })
+	if i < len(n.labels) && n.labels[i] == c {
+		return n.children[i]
+	}
+	child := &trieNode{}
+	n.labels = append(n.labels, 0)
+	copy(n.labels[i+1:], n.labels[i:])
+	n.labels[i] = c
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = child
+	return child
+}
@@ -1,13 +1,19 @@
 // Command dictgen generates data/dict.txt from kaikki.org Azerbaijani
-// dictionary dump (JSONL format).
+// dictionary dump (JSONL format), and data/dict.dawg, the minimal acyclic
+// automaton of the same stems that the morph package embeds.
 //
 // Download the dump from https://kaikki.org/dictionary/Azerbaijani/
 // then run:
 //
 //	go run ./cmd/dictgen -input kaikki.org-dictionary-Azerbaijani.jsonl
 //
-// Output: data/dict.txt (commit this file). Regenerate when a new
-// Wiktionary dump is available.
+// Output: data/dict.txt and data/dict.dawg (commit both files).
+// Regenerate when a new Wiktionary dump is available. After editing
+// dict.txt by hand, rebuild only the automaton:
+//
+//	go run ./cmd/dictgen -rebuild
+//
+// or run go generate ./data.
 package main
 
 import (
@@ -26,6 +32,7 @@ import (
 const (
 	defaultInput   = "data/dictionary/kaikki.org-dictionary-Azerbaijani.jsonl"
 	defaultOutput  = "data/dict.txt"
+	defaultDAWG    = "data/dict.dawg"
 	scannerBufSize = 1 << 20 // 1 MB
 	minLemmaRunes  = 2
 )
@@ -39,8 +46,20 @@ type kaikkiEntry struct {
 func main() {
 	inputPath := flag.String("input", defaultInput, "path to kaikki.org JSONL dump")
 	outputPath := flag.String("output", defaultOutput, "output path for dict.txt")
+	dawgPath := flag.String("automaton", defaultDAWG, "output path for dict.dawg")
+	rebuild := flag.Bool("rebuild", false, "rebuild the automaton from an existing dict.txt (-output) without a dump")
 	flag.Parse()
 
+	if *rebuild {
+		lines, err := readLines(*outputPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "dictgen: read dictionary: %v\n", err)
+			os.Exit(1)
+		}
+		writeAutomaton(lines, *dawgPath)
+		return
+	}
+
 	if *inputPath == "" {
 		fmt.Fprintf(os.Stderr, "Usage: dictgen -input <file> [-output <file>]\n")
 		os.Exit(1)
@@ -108,8 +127,8 @@ func main() {
 	for key := range seen {
 		lines = append(lines, key)
 	}
-	// Sort by lemma (from index 1), not by POS+lemma, so that the embedded
-	// dictLemmas slice in morph/dict.go is already sorted after stripping POS.
+	// Sort by lemma (from index 1), not by POS+lemma, so that dict.txt lists
+	// stems in the byte order the automaton enumerates them.
 	// Ties broken by POS byte for deterministic output.
 	sort.Slice(lines, func(i, j int) bool {
 		li, lj := lines[i][1:], lines[j][1:]
@@ -159,6 +178,39 @@ func main() {
 	fmt.Fprintf(os.Stderr, "  D (adv/intj/conj/postp/particle): %d\n", posCounts['D'])
 	fmt.Fprintf(os.Stderr, "  X (other):                  %d\n", posCounts['X'])
 	fmt.Fprintf(os.Stderr, "Output file: %s (%d bytes)\n", *outputPath, info.Size())
+
+	writeAutomaton(lines, *dawgPath)
+}
+
+// readLines returns the non-empty lines of a dict.txt file.
+func readLines(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var lines []string
+	for _, l := range strings.Split(string(data), "\n") {
+		if l != "" {
+			lines = append(lines, l)
+		}
+	}
+	return lines, nil
+}
+
+// writeAutomaton builds the stem automaton from dict.txt lines and writes
+// it to path, exiting on failure.
+func writeAutomaton(lines []string, path string) {
+	dawg, err := buildAutomaton(lines)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "dictgen: build automaton: %v\n", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(path, dawg, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "dictgen: write automaton: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Fprintf(os.Stderr, "Automaton file: %s (%d bytes, %d edges)\n",
+		path, len(dawg), (len(dawg)-dawgHeaderLen)/4)
 }
 
 // mapPOS maps a kaikki POS tag to a single-byte category.
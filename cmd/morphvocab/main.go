@@ -0,0 +1,205 @@
+// Command morphvocab builds a subword vocabulary from an Azerbaijani
+// corpus by splitting every word at its morpheme boundaries with
+// morph.Segment.
+//
+// Text is read from the files given as arguments, or from standard input
+// if there are none, and split into words with tokenizer.Words:
+//
+//	go run ./cmd/morphvocab -format wordpiece -min-count 5 corpus/*.txt > vocab.txt
+//
+// The wordpiece format writes one piece per line: the BERT special tokens,
+// then every character of the corpus in both forms (c and ##c) so that
+// any word can be encoded, then pieces by descending frequency. The
+// sentencepiece format writes <piece>\t<log probability> lines in the
+// .vocab layout, marking word-initial pieces with ▁ instead of marking
+// continuations with ##.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/morph"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+const (
+	scannerBufSize = 1 << 20  // 1 MB, longest accepted input line
+	spaceMarker    = "\u2581" // SentencePiece word-boundary marker ▁
+)
+
+// wordPieceSpecials are the special tokens of a BERT-style vocabulary.
+var wordPieceSpecials = []string{"[PAD]", "[UNK]", "[CLS]", "[SEP]", "[MASK]"}
+
+// sentencePieceSpecials are the control symbols of a SentencePiece model.
+var sentencePieceSpecials = []string{"<unk>", "<s>", "</s>"}
+
+// pieceCount is one vocabulary entry with its corpus frequency.
+type pieceCount struct {
+	piece string
+	count int
+}
+
+func main() {
+	format := flag.String("format", "wordpiece", "vocabulary format: wordpiece or sentencepiece")
+	minCount := flag.Int("min-count", 2, "drop pieces seen fewer times")
+	size := flag.Int("size", 0, "maximum vocabulary size including special tokens (0 = unlimited)")
+	lower := flag.Bool("lower", false, "lowercase words before segmentation")
+	flag.Parse()
+
+	if *format != "wordpiece" && *format != "sentencepiece" {
+		fmt.Fprintf(os.Stderr, "Usage: morphvocab [-format wordpiece|sentencepiece] [-min-count n] [-size n] [-lower] [file ...]\n")
+		os.Exit(1)
+	}
+
+	counts := make(map[string]int)
+	var err error
+	if flag.NArg() == 0 {
+		err = countPieces(os.Stdin, counts, *lower)
+	}
+	for _, path := range flag.Args() {
+		if err = countFile(path, counts, *lower); err != nil {
+			break
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "morphvocab: %v\n", err)
+		os.Exit(1)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	if *format == "wordpiece" {
+		writeWordPiece(w, counts, *minCount, *size)
+	} else {
+		writeSentencePiece(w, counts, *minCount, *size)
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintf(os.Stderr, "morphvocab: write: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// countFile adds the pieces of the file at path to counts.
+func countFile(path string, counts map[string]int, lower bool) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	err = countPieces(f, counts, lower)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// countPieces adds the pieces of every word in r to counts, line by line.
+// Word-initial pieces are counted bare and continuations with ##.
+func countPieces(r io.Reader, counts map[string]int, lower bool) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), scannerBufSize)
+	for scanner.Scan() {
+		for _, word := range tokenizer.Words(scanner.Text()) {
+			if lower {
+				word = azcase.ToLower(word)
+			}
+			for _, p := range morph.Segment(word) {
+				counts[p]++
+			}
+		}
+	}
+	return scanner.Err()
+}
+
+// sortedPieces returns the pieces seen at least minCount times, most
+// frequent first, ties in byte order.
+func sortedPieces(counts map[string]int, minCount int) []pieceCount {
+	pcs := make([]pieceCount, 0, len(counts))
+	for p, c := range counts {
+		if c >= minCount {
+			pcs = append(pcs, pieceCount{p, c})
+		}
+	}
+	sort.Slice(pcs, func(i, j int) bool {
+		if pcs[i].count != pcs[j].count {
+			return pcs[i].count > pcs[j].count
+		}
+		return pcs[i].piece < pcs[j].piece
+	})
+	return pcs
+}
+
+// writeWordPiece writes a WordPiece vocab.txt. The special tokens and the
+// character alphabet are always kept; size caps the remaining pieces.
+func writeWordPiece(w io.Writer, counts map[string]int, minCount, size int) {
+	seen := make(map[string]bool)
+	var vocab []string
+	add := func(p string) {
+		if !seen[p] {
+			seen[p] = true
+			vocab = append(vocab, p)
+		}
+	}
+	for _, s := range wordPieceSpecials {
+		add(s)
+	}
+
+	chars := make(map[rune]bool)
+	for p := range counts {
+		for _, r := range strings.TrimPrefix(p, morph.ContinuationPrefix) {
+			chars[r] = true
+		}
+	}
+	alphabet := make([]string, 0, len(chars))
+	for r := range chars {
+		alphabet = append(alphabet, string(r))
+	}
+	sort.Strings(alphabet)
+	for _, c := range alphabet {
+		add(c)
+	}
+	for _, c := range alphabet {
+		add(morph.ContinuationPrefix + c)
+	}
+
+	for _, pc := range sortedPieces(counts, minCount) {
+		if size > 0 && len(vocab) >= size {
+			break
+		}
+		add(pc.piece)
+	}
+	for _, p := range vocab {
+		fmt.Fprintln(w, p)
+	}
+}
+
+// writeSentencePiece writes a SentencePiece .vocab file: control symbols
+// with score 0, then pieces with their log probability.
+func writeSentencePiece(w io.Writer, counts map[string]int, minCount, size int) {
+	pcs := sortedPieces(counts, minCount)
+	total := 0
+	for _, pc := range pcs {
+		total += pc.count
+	}
+	for _, s := range sentencePieceSpecials {
+		fmt.Fprintf(w, "%s\t0\n", s)
+	}
+	n := len(sentencePieceSpecials)
+	for _, pc := range pcs {
+		if size > 0 && n >= size {
+			break
+		}
+		piece, ok := strings.CutPrefix(pc.piece, morph.ContinuationPrefix)
+		if !ok {
+			piece = spaceMarker + piece
+		}
+		fmt.Fprintf(w, "%s\t%.4f\n", piece, math.Log(float64(pc.count)/float64(total)))
+		n++
+	}
+}
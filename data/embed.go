@@ -3,8 +3,14 @@ package data
 
 import _ "embed"
 
-//go:embed dict.txt
-var MorphDict []byte
+//go:generate go run ../cmd/dictgen -rebuild -output dict.txt -automaton dict.dawg
+
+// MorphDictDAWG is the minimal acyclic automaton of the stems in dict.txt,
+// generated by cmd/dictgen. dict.txt itself, the source of the automaton,
+// is not embedded.
+//
+//go:embed dict.dawg
+var MorphDictDAWG []byte
 
 //go:embed spell_freq.txt
 var SpellFreq []byte
@@ -14,3 +20,15 @@ var KeywordsFreq []byte
 
 //go:embed lexicon.txt
 var SentimentLexicon string
+
+// HarmonyExceptions lists loanword stems whose suffixes follow a harmony
+// class other than their last vowel.
+//
+//go:embed harmony.txt
+var HarmonyExceptions []byte
+
+// ClosedCompounds lists lexicalized compounds written as one word, with
+// their parts joined by +.
+//
+//go:embed compounds.txt
+var ClosedCompounds []byte
@@ -385,6 +385,17 @@ func TestMalformedUTF8(t *testing.T) {
 	}
 }
 
+// TestRussianCyrillic checks text with Russian letters that Azerbaijani
+// Cyrillic transliteration leaves in place.
+func TestRussianCyrillic(t *testing.T) {
+	input := "Bakı şəhəri энциклопедия Bakı şəhəri я"
+	_ = ExtractTFIDF(input, 5)
+	_ = ExtractTextRank(input, 5)
+	if got := Keywords(input); len(got) == 0 {
+		t.Errorf("Keywords(%q) returned no keywords", input)
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Benchmarks
 // ---------------------------------------------------------------------------
@@ -0,0 +1,154 @@
+// Configurable analyzer for Azerbaijani morphological analysis.
+//
+// An Analyzer layers a user lexicon over the embedded dictionary: extra
+// stems with a part of speech, blocked stems that are never returned,
+// exception entries that fix the stem of a whole word, and stem glosses
+// for interlinear glossing. The package-level functions (Analyze, Stem,
+// Lemmatize, ...) use a default Analyzer with no overrides. Overrides are
+// applied in the walker base case and in the dictionary-aware ranking, so
+// the suffix FSM itself is shared.
+package morph
+
+import (
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Options configures an Analyzer. Keys are matched case-insensitively.
+type Options struct {
+	// Stems adds domain stems to the dictionary, or overrides the part
+	// of speech of existing ones. POSUnknown is treated as POSNoun.
+	Stems map[string]POS
+
+	// Blocked lists stems that are never returned as the stem of an
+	// analysis, even if the dictionary contains them.
+	Blocked []string
+
+	// Exceptions maps whole words to the stem Stem and Lemmatize must
+	// return for them (e.g. proper names that look inflected).
+	Exceptions map[string]string
+
+	// Glosses maps stems to the gloss written for them in interlinear
+	// glossed text (kitab: book), in place of the stem itself.
+	Glosses map[string]string
+}
+
+// Analyzer is a morphological analyzer with a user lexicon layered over
+// the embedded dictionary. An Analyzer is immutable after construction
+// and safe for concurrent use. The zero Analyzer behaves like the
+// package-level functions.
+type Analyzer struct {
+	stems      map[string]POS
+	blocked    map[string]struct{}
+	exceptions map[string]string
+	glosses    map[string]string
+}
+
+// defaultAnalyzer backs the package-level functions.
+var defaultAnalyzer = &Analyzer{}
+
+// NewAnalyzer returns an Analyzer configured by opts. The option maps are
+// copied, so later changes to opts do not affect the Analyzer. Empty keys
+// and values are ignored.
+func NewAnalyzer(opts Options) *Analyzer {
+	an := &Analyzer{
+		stems:      make(map[string]POS, len(opts.Stems)),
+		blocked:    make(map[string]struct{}, len(opts.Blocked)),
+		exceptions: make(map[string]string, len(opts.Exceptions)),
+		glosses:    make(map[string]string, len(opts.Glosses)),
+	}
+	for s, pos := range opts.Stems {
+		if s = lexiconKey(s); s == "" {
+			continue
+		}
+		if pos == POSUnknown {
+			pos = POSNoun
+		}
+		an.stems[s] = pos
+	}
+	for _, s := range opts.Blocked {
+		if s = lexiconKey(s); s != "" {
+			an.blocked[s] = struct{}{}
+		}
+	}
+	for word, stem := range opts.Exceptions {
+		word, stem = lexiconKey(word), lexiconKey(stem)
+		if word != "" && stem != "" {
+			an.exceptions[word] = stem
+		}
+	}
+	for stem, gloss := range opts.Glosses {
+		stem, gloss = lexiconKey(stem), strings.TrimSpace(gloss)
+		if stem != "" && gloss != "" {
+			an.glosses[stem] = gloss
+		}
+	}
+	return an
+}
+
+// lexiconKey normalizes a user lexicon entry to the lowercase NFC form
+// used for dictionary lookups.
+func lexiconKey(s string) string {
+	return azcase.ToLower(azcase.ComposeNFC(strings.TrimSpace(s)))
+}
+
+// IsKnownStem reports whether s is a known stem: an extra stem of the
+// Analyzer or a dictionary stem that is not blocked.
+// Expects lowercase Azerbaijani Latin input.
+func (an *Analyzer) IsKnownStem(s string) bool {
+	return an.isKnownStem(s)
+}
+
+// LookupPOS returns the part of speech of a known stem, or POSUnknown if
+// s is unknown or blocked. Extra stems take precedence over the pronoun
+// table, which takes precedence over the dictionary.
+// Expects lowercase Azerbaijani Latin input.
+func (an *Analyzer) LookupPOS(s string) POS {
+	if an.isBlocked(s) {
+		return POSUnknown
+	}
+	if pos, ok := an.stems[s]; ok {
+		return pos
+	}
+	if isPronoun(s) {
+		return POSPronoun
+	}
+	return posFromByte(stemPOS(s))
+}
+
+// isKnownStem is IsKnownStem for internal callers.
+func (an *Analyzer) isKnownStem(s string) bool {
+	if s == "" || an.isBlocked(s) {
+		return false
+	}
+	if _, ok := an.stems[s]; ok {
+		return true
+	}
+	return isKnownStem(s) || isPronoun(s)
+}
+
+// isBlocked reports whether s is a blocked stem.
+// Expects lowercase Latin input.
+func (an *Analyzer) isBlocked(s string) bool {
+	_, ok := an.blocked[s]
+	return ok
+}
+
+// exception returns the exception stem of word, cased like word, and
+// whether one is configured.
+func (an *Analyzer) exception(word string) (string, bool) {
+	if len(an.exceptions) == 0 {
+		return "", false
+	}
+	stem, ok := an.exceptions[azcase.ToLower(word)]
+	if !ok {
+		return "", false
+	}
+	if rs := []rune(word); len(rs) > 0 && rs[0] != azcase.Lower(rs[0]) {
+		sr := []rune(stem)
+		sr[0] = azcase.Upper(sr[0])
+		return string(sr), true
+	}
+	return stem, true
+}
@@ -0,0 +1,159 @@
+package morph
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func testAnalyzer() *Analyzer {
+	return NewAnalyzer(Options{
+		Stems:      map[string]POS{"selfi": POSNoun, "Tvitlə": POSVerb},
+		Blocked:    []string{"al"},
+		Exceptions: map[string]string{"nəsimi": "nəsimi"},
+	})
+}
+
+func TestAnalyzerStem(t *testing.T) {
+	an := testAnalyzer()
+	tests := []struct {
+		word        string
+		wantDefault string
+		want        string
+	}{
+		// Extra stem keeps its final vowel.
+		{"selfini", "self", "selfi"},
+		{"selfilər", "self", "selfi"},
+		// Blocked al exposes the vowel-drop stem alın.
+		{"alnı", "al", "alın"},
+		// Exception overrides a spurious possessive parse, keeping case.
+		{"Nəsimi", "Nəs", "Nəsimi"},
+		{"nəsimi", "nəs", "nəsimi"},
+		// Words outside the lexicon are unaffected.
+		{"kitablarımızdan", "kitab", "kitab"},
+		{"gəldim", "gəl", "gəl"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.wantDefault {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.wantDefault)
+			}
+			if got := an.Stem(tt.word); got != tt.want {
+				t.Errorf("Analyzer.Stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzerAnalyze(t *testing.T) {
+	an := testAnalyzer()
+
+	results := an.Analyze("selfilər")
+	if results[0].Stem != "selfi" {
+		t.Errorf("Analyze(selfilər)[0] = %v, want stem selfi", results[0])
+	}
+
+	for _, a := range an.Analyze("alır") {
+		if a.Stem == "al" {
+			t.Errorf("Analyze(alır) returned blocked stem: %v", a)
+		}
+	}
+
+	results = an.Analyze("Nəsimi")
+	if results[0].Stem != "Nəsimi" || len(results[0].Morphemes) != 0 {
+		t.Errorf("Analyze(Nəsimi)[0] = %v, want bare Nəsimi", results[0])
+	}
+}
+
+func TestAnalyzerLookup(t *testing.T) {
+	an := testAnalyzer()
+	tests := []struct {
+		stem  string
+		known bool
+		pos   POS
+	}{
+		{"selfi", true, POSNoun},
+		{"tvitlə", true, POSVerb},
+		{"al", false, POSUnknown},
+		{"kitab", true, POSNoun},
+		{"", false, POSUnknown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.stem, func(t *testing.T) {
+			if got := an.IsKnownStem(tt.stem); got != tt.known {
+				t.Errorf("IsKnownStem(%q) = %v, want %v", tt.stem, got, tt.known)
+			}
+			if got := an.LookupPOS(tt.stem); got != tt.pos {
+				t.Errorf("LookupPOS(%q) = %v, want %v", tt.stem, got, tt.pos)
+			}
+		})
+	}
+	if !IsKnownStem("al") {
+		t.Error("blocking al in an Analyzer changed the default dictionary")
+	}
+}
+
+func TestAnalyzerLemmatize(t *testing.T) {
+	an := testAnalyzer()
+	got := an.Lemmatize("tvitlədim")
+	if got.Form != "tvitləmək" || got.POS != POSVerb {
+		t.Errorf("Lemmatize(tvitlədim) = %q %v, want tvitləmək Verb", got.Form, got.POS)
+	}
+	if p := an.Paradigm("selfi"); p.POS != POSNoun || p.Form(Plural, CaseLoc) != "selfilərdə" {
+		t.Errorf("Paradigm(selfi) = %v %q, want Noun selfilərdə", p.POS, p.Form(Plural, CaseLoc))
+	}
+}
+
+func TestAnalyzerZeroValue(t *testing.T) {
+	var an Analyzer
+	for _, w := range []string{"kitablarımızdan", "alnı", "gəldim", "oğlum"} {
+		if got, want := an.Stem(w), Stem(w); got != want {
+			t.Errorf("zero Analyzer.Stem(%q) = %q, want %q", w, got, want)
+		}
+	}
+}
+
+func TestAnalyzerOptionsCopied(t *testing.T) {
+	stems := map[string]POS{"selfi": POSNoun}
+	an := NewAnalyzer(Options{Stems: stems})
+	delete(stems, "selfi")
+	if got := an.Stem("selfini"); got != "selfi" {
+		t.Errorf("Stem(selfini) = %q after mutating options, want selfi", got)
+	}
+}
+
+func TestAnalyzerConcurrent(t *testing.T) {
+	an := testAnalyzer()
+	words := []string{"selfilər", "alnı", "Nəsimi", "kitablarımızdan", "gəldim"}
+	want := an.Stems(words)
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			for range 50 {
+				got := an.Stems(words)
+				for i := range got {
+					if got[i] != want[i] {
+						t.Errorf("Stem(%q) = %q, want %q", words[i], got[i], want[i])
+					}
+				}
+				_ = Stems(words)
+			}
+		})
+	}
+	wg.Wait()
+}
+
+func ExampleNewAnalyzer() {
+	an := NewAnalyzer(Options{
+		Stems:   map[string]POS{"selfi": POSNoun},
+		Blocked: []string{"al"},
+	})
+	fmt.Println(Stem("selfilər"), an.Stem("selfilər"))
+	fmt.Println(Stem("alnı"), an.Stem("alnı"))
+	// Output:
+	// self selfi
+	// al alın
+}
@@ -0,0 +1,246 @@
+// Compound and reduplication structure for Azerbaijani morphological analysis.
+//
+// Analyze attaches a Compound to every analysis whose stem is made of
+// several words: hyphenated forms (elmi-tədqiqat, qap-qara, kitab-mitab,
+// ağ-ağ) and closed forms (dəmiryol, qapqara). The suffix chain of the
+// analysis belongs to the compound as a whole and is not repeated on the
+// parts. Closed compounds come from the curated list in
+// data/compounds.txt, since a stem that merely splits into two dictionary
+// words is rarely a compound (bir+inci, yan+var); emphatic prefixes
+// (qapqara) are recognized by rule.
+package morph
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// closedCompounds maps lowercase closed compounds to their parts,
+// populated by init().
+var closedCompounds map[string][]string
+
+func init() {
+	closedCompounds = parseClosedCompounds(data.ClosedCompounds)
+}
+
+// parseClosedCompounds parses lines of parts joined by + (dəmir+yol).
+// Lines with fewer than two parts or an invalid part are skipped.
+func parseClosedCompounds(raw []byte) map[string][]string {
+	m := make(map[string][]string)
+	for _, line := range bytes.Split(raw, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		parts := strings.Split(azcase.ToLower(azcase.ComposeNFC(string(line))), "+")
+		if len(parts) < 2 || len(parts) > maxCompoundParts {
+			continue
+		}
+		valid := true
+		for _, p := range parts {
+			valid = valid && isValidStem(p)
+		}
+		if valid {
+			m[strings.Join(parts, "")] = parts
+		}
+	}
+	return m
+}
+
+// Reduplication classifies how the parts of a compound relate.
+type Reduplication int
+
+const (
+	RedupNone     Reduplication = iota // ordinary compound (dəmiryol, elmi-tədqiqat)
+	RedupEmphatic                      // emphatic prefix (qap-qara, qapqara, sapsarı)
+	RedupM                             // m-reduplication, "and the like" (kitab-mitab)
+	RedupFull                          // full repetition (ağ-ağ, tez-tez)
+)
+
+// redupNames maps Reduplication values to their string names.
+var redupNames = [...]string{
+	RedupNone:     "None",
+	RedupEmphatic: "Emphatic",
+	RedupM:        "M",
+	RedupFull:     "Full",
+}
+
+// redupFromName maps string names back to Reduplication values.
+var redupFromName = map[string]Reduplication{
+	"None":     RedupNone,
+	"Emphatic": RedupEmphatic,
+	"M":        RedupM,
+	"Full":     RedupFull,
+}
+
+// String returns the name of the reduplication type.
+func (r Reduplication) String() string {
+	if int(r) >= 0 && int(r) < len(redupNames) {
+		return redupNames[r]
+	}
+	return fmt.Sprintf("Reduplication(%d)", int(r))
+}
+
+// MarshalJSON encodes the reduplication type as a JSON string (e.g. "Full").
+func (r Reduplication) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Full") into a Reduplication.
+func (r *Reduplication) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	red, ok := redupFromName[s]
+	if !ok {
+		return fmt.Errorf("morph: unknown reduplication: %q", s)
+	}
+	*r = red
+	return nil
+}
+
+// Compound is the internal structure of a multi-word stem.
+type Compound struct {
+	Parts         []Analysis    `json:"parts"`         // Components in surface order
+	Reduplication Reduplication `json:"reduplication"` // How the parts relate
+}
+
+// maxCompoundParts caps the hyphen-separated parts that are analyzed.
+// Longer chains are left without structure.
+const maxCompoundParts = 8
+
+// emphaticCloser lists the consonants that close an emphatic prefix
+// (qa-p-qara, ya-m-yaşıl, bü-s-bütün, tə-r-təmiz).
+var emphaticCloser = map[rune]bool{'p': true, 'm': true, 's': true, 'r': true}
+
+// compound returns the structure of stem, or nil if stem is a single word.
+func (an *Analyzer) compound(stem string) *Compound {
+	if strings.Contains(stem, "-") {
+		return an.hyphenCompound(stem)
+	}
+	return an.closedCompound(stem)
+}
+
+// hyphenCompound splits a hyphenated stem. Reduplication is recognized
+// only between two parts; the parts of reduplications are bare, with
+// Score 1, and each part of an ordinary compound gets the analysis Stem
+// selects for it, with its Score.
+func (an *Analyzer) hyphenCompound(stem string) *Compound {
+	parts := strings.Split(stem, "-")
+	if len(parts) > maxCompoundParts {
+		return nil
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil
+		}
+	}
+	if len(parts) == 2 {
+		if red := an.reduplication(parts[0], parts[1]); red != RedupNone {
+			return &Compound{
+				Parts:         []Analysis{{Stem: parts[0], Score: 1}, {Stem: parts[1], Score: 1}},
+				Reduplication: red,
+			}
+		}
+	}
+	c := &Compound{Parts: make([]Analysis, len(parts))}
+	for i, p := range parts {
+		c.Parts[i] = an.analysisForStem(an.analyzeStem(p))
+	}
+	return c
+}
+
+// closedCompound splits a stem written as one word into an emphatic
+// prefix and its base (qapqara), or into the parts of a listed compound
+// (dəmiryol). The parts are bare, with Score 1. Returns nil if neither
+// split applies.
+func (an *Analyzer) closedCompound(stem string) *Compound {
+	low := []rune(azcase.ToLower(stem))
+	orig := []rune(stem)
+	if len(orig) != len(low) {
+		return nil
+	}
+	if n := emphaticPrefixLen(low); n > 0 && an.isEmphaticBase(string(low[n:]), string(low)) {
+		return &Compound{
+			Parts:         []Analysis{{Stem: string(orig[:n]), Score: 1}, {Stem: string(orig[n:]), Score: 1}},
+			Reduplication: RedupEmphatic,
+		}
+	}
+	parts, ok := closedCompounds[string(low)]
+	if !ok {
+		return nil
+	}
+	c := &Compound{Parts: make([]Analysis, len(parts))}
+	k := 0
+	for i, p := range parts {
+		n := utf8.RuneCountInString(p)
+		c.Parts[i] = Analysis{Stem: string(orig[k : k+n]), Score: 1}
+		k += n
+	}
+	return c
+}
+
+// reduplication classifies the relation between the two parts of a
+// hyphenated stem.
+func (an *Analyzer) reduplication(first, second string) Reduplication {
+	a, b := []rune(azcase.ToLower(first)), []rune(azcase.ToLower(second))
+	switch {
+	case string(a) == string(b):
+		return RedupFull
+	case isMReduplication(a, b):
+		return RedupM
+	case emphaticPrefixLen(append(a, b...)) == len(a) && an.isEmphaticBase(string(b), string(a)+string(b)):
+		return RedupEmphatic
+	}
+	return RedupNone
+}
+
+// isEmphaticBase reports whether the lowercase base may follow an emphatic
+// prefix in word: base is a known adjective (qara, təmiz), or word itself
+// is a dictionary stem (büsbütün). This keeps words that merely repeat a
+// syllable (dəmdəmə, əsəs) from being read as emphatic forms.
+func (an *Analyzer) isEmphaticBase(base, word string) bool {
+	return an.LookupPOS(base) == POSAdj || an.isKnownStem(word)
+}
+
+// isMReduplication reports whether b echoes a with its initial consonant
+// replaced by m (kitab-mitab), or m prepended before an initial vowel
+// (alma-malma). Words already starting with m do not take this pattern.
+func isMReduplication(a, b []rune) bool {
+	if len(a) < 2 || len(b) < 2 || a[0] == 'm' || b[0] != 'm' {
+		return false
+	}
+	if isVowel(a[0]) {
+		return string(b[1:]) == string(a)
+	}
+	return string(b[1:]) == string(a[1:])
+}
+
+// emphaticPrefixLen returns the length of the emphatic prefix at the start
+// of the lowercase word w: the onset of the base up to its first vowel,
+// followed by p, m, s or r, and then the base itself (qap|qara, sap|sarı).
+// Returns 0 if w does not start with such a prefix.
+func emphaticPrefixLen(w []rune) int {
+	v := -1
+	for i, r := range w {
+		if isVowel(r) {
+			v = i
+			break
+		}
+	}
+	if v < 0 || v+2 >= len(w) || !emphaticCloser[w[v+1]] {
+		return 0
+	}
+	n := v + 2
+	if len(w)-n < max(v+1, 2) || string(w[n:n+v+1]) != string(w[:v+1]) {
+		return 0
+	}
+	return n
+}
@@ -0,0 +1,190 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// compoundFor returns the Compound of the analysis of word with the given
+// stem, or nil if there is none.
+func compoundFor(word, stem string) *Compound {
+	for _, a := range Analyze(word) {
+		if a.Stem == stem {
+			return a.Compound
+		}
+	}
+	return nil
+}
+
+func TestAnalyzeCompound(t *testing.T) {
+	tests := []struct {
+		word  string
+		stem  string
+		parts []string
+		red   Reduplication
+	}{
+		// -- Hyphenated reduplication --
+		{"qap-qara", "qap-qara", []string{"qap", "qara"}, RedupEmphatic},
+		{"kitab-mitab", "kitab-mitab", []string{"kitab", "mitab"}, RedupM},
+		{"ət-mət", "ət-mət", []string{"ət", "mət"}, RedupM},
+		{"alma-malma", "alma-malma", []string{"alma", "malma"}, RedupM},
+		{"ağ-ağ", "ağ-ağ", []string{"ağ", "ağ"}, RedupFull},
+		{"tez-tez", "tez-tez", []string{"tez", "tez"}, RedupFull},
+
+		// -- Inflection stays on the compound --
+		{"kitab-mitablar", "kitab-mitab", []string{"kitab", "mitab"}, RedupM},
+		{"dəmiryolda", "dəmiryol", []string{"dəmir", "yol"}, RedupNone},
+
+		// -- Closed forms --
+		{"dəmiryol", "dəmiryol", []string{"dəmir", "yol"}, RedupNone},
+		{"ayaqqabılar", "ayaqqabı", []string{"ayaq", "qabı"}, RedupNone},
+		{"kitabxanada", "kitabxana", []string{"kitab", "xana"}, RedupNone},
+		{"sualtı", "sualtı", []string{"su", "altı"}, RedupNone},
+		{"qapqara", "qapqara", []string{"qap", "qara"}, RedupEmphatic},
+		{"sapsarı", "sapsarı", []string{"sap", "sarı"}, RedupEmphatic},
+		{"büsbütün", "büsbütün", []string{"büs", "bütün"}, RedupEmphatic},
+
+		// -- Ordinary hyphenated compounds --
+		{"elmi-tədqiqat", "elmi-tədqiqat", []string{"elmi", "tədqiqat"}, RedupNone},
+
+		// -- Case preservation --
+		{"Qap-qara", "Qap-qara", []string{"Qap", "qara"}, RedupEmphatic},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			c := compoundFor(tt.word, tt.stem)
+			if c == nil {
+				t.Fatalf("Analyze(%q): no compound on stem %q: %v", tt.word, tt.stem, Analyze(tt.word))
+			}
+			var parts []string
+			for _, p := range c.Parts {
+				parts = append(parts, p.Stem)
+			}
+			if strings.Join(parts, "+") != strings.Join(tt.parts, "+") || c.Reduplication != tt.red {
+				t.Errorf("Analyze(%q) compound = %v %v, want %v %v", tt.word, parts, c.Reduplication, tt.parts, tt.red)
+			}
+		})
+	}
+}
+
+func TestAnalyzeNoCompound(t *testing.T) {
+	for _, w := range []string{"kitab", "evlərdə", "gəlmişdir", "-kitab", "kitab-", "a--b", strings.Repeat("a-", 100) + "a"} {
+		if c := compoundFor(w, w); c != nil {
+			t.Errorf("Analyze(%q) compound = %v, want nil", w, c)
+		}
+	}
+}
+
+// TestAnalyzeNoClosedCompound checks that stems which split into two
+// dictionary words, or repeat a syllable of a base that is not an
+// adjective, are not taken for compounds.
+func TestAnalyzeNoClosedCompound(t *testing.T) {
+	for _, w := range []string{"birinci", "yanvar", "yazmaq", "paltar", "operator", "olmayan", "qardaş", "dəmdəmə", "əsəs"} {
+		for _, a := range Analyze(w) {
+			if a.Compound != nil {
+				t.Errorf("Analyze(%q) stem %q compound = %v, want nil", w, a.Stem, a.Compound.Parts)
+			}
+		}
+	}
+}
+
+// TestStemReduplication checks that reduplications are stemmed and ranked
+// as a whole, with the suffixes stripped from the echo too.
+func TestStemReduplication(t *testing.T) {
+	tests := []struct{ word, stem string }{
+		{"alma-malma", "alma-malma"},
+		{"alma-malmalar", "alma-malma"},
+		{"kitab-mitab", "kitab-mitab"},
+		{"kitabları-mitabları", "kitab-mitab"},
+		{"qap-qara", "qap-qara"},
+		{"ağ-ağ", "ağ-ağ"},
+	}
+	for _, tt := range tests {
+		if got := Stem(tt.word); got != tt.stem {
+			t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.stem)
+		}
+	}
+	if a := Analyze("alma-malma")[0]; a.Stem != "alma-malma" || a.Compound == nil || a.Compound.Reduplication != RedupM {
+		t.Errorf("Analyze(alma-malma)[0] = %v %v, want the m-reduplication", a, a.Compound)
+	}
+	// dəmə is not an adjective, so dəm- is no emphatic prefix.
+	if c := compoundFor("dəm-dəmə", "dəm-dəmə"); c == nil || c.Reduplication != RedupNone {
+		t.Errorf("Analyze(dəm-dəmə) compound = %v, want an ordinary compound", c)
+	}
+}
+
+func TestClosedCompoundsData(t *testing.T) {
+	if len(closedCompounds) == 0 {
+		t.Fatal("closed compound list is empty")
+	}
+	for s, parts := range closedCompounds {
+		if strings.Join(parts, "") != s || len(parts) < 2 {
+			t.Errorf("invalid entry %q -> %q", s, parts)
+		}
+	}
+}
+
+func TestParseClosedCompounds(t *testing.T) {
+	raw := []byte("# comment\n\nDəmir+Yol\nkitab\nsu+\nağ+su+yol\n")
+	got := parseClosedCompounds(raw)
+	want := map[string]string{"dəmiryol": "dəmir yol", "ağsuyol": "ağ su yol"}
+	if len(got) != len(want) {
+		t.Fatalf("parseClosedCompounds = %q, want %q", got, want)
+	}
+	for s, parts := range want {
+		if strings.Join(got[s], " ") != parts {
+			t.Errorf("parseClosedCompounds[%q] = %q, want %q", s, got[s], parts)
+		}
+	}
+}
+
+func TestReduplicationJSON(t *testing.T) {
+	for _, r := range []Reduplication{RedupNone, RedupEmphatic, RedupM, RedupFull} {
+		data, err := json.Marshal(r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Reduplication
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got != r {
+			t.Errorf("round trip %v = %v", r, got)
+		}
+	}
+	var r Reduplication
+	if err := json.Unmarshal([]byte(`"Partial"`), &r); err == nil {
+		t.Error("Unmarshal(Partial) succeeded, want error")
+	}
+}
+
+func TestAnalysisCompoundJSON(t *testing.T) {
+	data, err := json.Marshal(Analysis{Stem: "ağ-ağ", Compound: compoundFor("ağ-ağ", "ağ-ağ")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"stem":"ağ-ağ","morphemes":null,"compound":{"parts":[{"stem":"ağ","morphemes":null,"score":1},{"stem":"ağ","morphemes":null,"score":1}],"reduplication":"Full"}}`
+	if string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+}
+
+func BenchmarkAnalyzeCompound(b *testing.B) {
+	for b.Loop() {
+		Analyze("kitab-mitablarımızdan")
+	}
+}
+
+func ExampleCompound() {
+	for _, w := range []string{"kitab-mitab", "ağ-ağ", "dəmiryolda"} {
+		a := Analyze(w)[0]
+		fmt.Println(a, a.Compound.Parts, a.Compound.Reduplication)
+	}
+	// Output:
+	// kitab-mitab [kitab mitab] M
+	// ağ-ağ [ağ ağ] Full
+	// dəmiryol[CaseLoc:da] [dəmir yol] None
+}
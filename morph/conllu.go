@@ -0,0 +1,151 @@
+// CoNLL-U export for Azerbaijani morphological analysis.
+//
+// WriteCoNLLU splits text with the tokenizer package and writes one
+// CoNLL-U block per sentence. Word tokens are disambiguated with
+// AnalyzeSentence and filled into the LEMMA, UPOS and FEATS columns;
+// syntactic columns (HEAD, DEPREL, DEPS) are left empty.
+package morph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/tokenizer"
+)
+
+// conlluEmpty is the CoNLL-U placeholder for an empty field.
+const conlluEmpty = "_"
+
+// WriteCoNLLU writes text to w in the CoNLL-U format, one block per
+// sentence with # sent_id and # text comments. Each non-space token is
+// one line: words carry their lemma, UPOS and FEATS; numbers are NUM,
+// punctuation PUNCT, symbols SYM, and URLs and emails X. Tokens not
+// followed by whitespace inside a sentence get SpaceAfter=No in MISC.
+//
+// Returns the first error from w. Empty text writes nothing.
+func WriteCoNLLU(w io.Writer, text string) error {
+	return defaultAnalyzer.WriteCoNLLU(w, text)
+}
+
+// WriteCoNLLU is like the package-level WriteCoNLLU but lemmatizes and
+// disambiguates with the Analyzer's lexicon.
+func (an *Analyzer) WriteCoNLLU(w io.Writer, text string) error {
+	bw := bufio.NewWriter(w)
+	id := 0
+	for _, sent := range tokenizer.SentenceTokens(text) {
+		toks := tokenizer.WordTokens(sent.Text)
+		var words []string
+		for _, t := range toks {
+			if t.Type == tokenizer.Word {
+				words = append(words, t.Text)
+			}
+		}
+		if !hasContent(toks) {
+			continue
+		}
+		analyses := an.AnalyzeSentence(words)
+
+		id++
+		fmt.Fprintf(bw, "# sent_id = %d\n", id)
+		fmt.Fprintf(bw, "# text = %s\n", conlluText(sent.Text))
+		n, wi := 0, 0
+		for i, t := range toks {
+			if t.Type == tokenizer.Space {
+				continue
+			}
+			n++
+			lemma, upos, feats := conlluEmpty, conlluEmpty, conlluEmpty
+			switch t.Type {
+			case tokenizer.Word:
+				l := an.lemmaForAnalysis(t.Text, analyses[wi])
+				wi++
+				lemma, upos = l.Form, l.UPOS()
+				if f := l.Feats(); f != "" {
+					feats = f
+				}
+			case tokenizer.Number:
+				lemma, upos = t.Text, "NUM"
+			case tokenizer.Punctuation:
+				lemma, upos = t.Text, "PUNCT"
+			case tokenizer.Symbol:
+				lemma, upos = t.Text, "SYM"
+			default:
+				lemma, upos = t.Text, "X"
+			}
+			misc := conlluEmpty
+			if i+1 < len(toks) && toks[i+1].Type != tokenizer.Space {
+				misc = "SpaceAfter=No"
+			}
+			fmt.Fprintf(bw, "%d\t%s\t%s\t%s\t_\t%s\t_\t_\t_\t%s\n",
+				n, conlluField(t.Text), conlluField(lemma), upos, feats, misc)
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// lemmaForAnalysis returns the lemma of word analysed as a. It is the
+// Lemmatize result when that agrees with a, and otherwise the stem of a
+// as a verb infinitive or with its dictionary part of speech. A word with
+// an apostrophe suffix (Bakı'nın) takes the Lemmatize stem, with the
+// suffix analysed on it (Bakı+nın) for the features.
+func (an *Analyzer) lemmaForAnalysis(word string, a Analysis) Lemma {
+	l := an.Lemmatize(word)
+	if i := strings.IndexAny(word, "'\u2019\u02BC"); i > 0 && l.Analysis.Stem == word[:i] {
+		_, size := utf8.DecodeRuneInString(word[i:])
+		for _, b := range an.Analyze(word[:i] + word[i+size:]) {
+			if b.Stem == l.Analysis.Stem {
+				l.Analysis = b
+				break
+			}
+		}
+		return l
+	}
+	if l.Analysis.Stem == a.Stem && tagsKey(l.Analysis.Morphemes) == tagsKey(a.Morphemes) {
+		return l
+	}
+	form := a.Stem
+	pos := an.LookupPOS(azcase.ToLower(form))
+	if isVerbal(a) {
+		pos = POSVerb
+		for _, cand := range verbStemCandidates(a) {
+			if an.isKnownStem(azcase.ToLower(cand)) {
+				form = cand
+				break
+			}
+		}
+	}
+	if pos == POSVerb {
+		form = citationForm(form)
+	}
+	return Lemma{Form: form, POS: pos, Analysis: a}
+}
+
+// hasContent reports whether toks contains a token other than whitespace.
+func hasContent(toks []tokenizer.Token) bool {
+	for _, t := range toks {
+		if t.Type != tokenizer.Space {
+			return true
+		}
+	}
+	return false
+}
+
+// conlluText returns a sentence for the # text comment: trimmed, with
+// internal line breaks replaced by spaces.
+func conlluText(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// conlluField returns s for a CoNLL-U column, which may not be empty or
+// contain tabs or newlines.
+func conlluField(s string) string {
+	if s == "" {
+		return conlluEmpty
+	}
+	return strings.NewReplacer("\t", " ", "\n", " ", "\r", " ").Replace(s)
+}
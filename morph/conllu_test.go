@@ -0,0 +1,80 @@
+package morph
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestWriteCoNLLU(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteCoNLLU(&sb, "Mən kitabları oxudum. Onlar 3 ev aldılar!"); err != nil {
+		t.Fatal(err)
+	}
+	want := "# sent_id = 1\n" +
+		"# text = Mən kitabları oxudum.\n" +
+		"1\tMən\tMən\tPRON\t_\tCase=Nom|Number=Sing|Person=1|PronType=Prs\t_\t_\t_\t_\n" +
+		"2\tkitabları\tkitab\tNOUN\t_\tCase=Acc|Number=Plur\t_\t_\t_\t_\n" +
+		"3\toxudum\toxumaq\tVERB\t_\tEvident=Fh|Mood=Ind|Number=Sing|Person=1|Polarity=Pos|Tense=Past|VerbForm=Fin\t_\t_\t_\tSpaceAfter=No\n" +
+		"4\t.\t.\tPUNCT\t_\t_\t_\t_\t_\t_\n" +
+		"\n" +
+		"# sent_id = 2\n" +
+		"# text = Onlar 3 ev aldılar!\n" +
+		"1\tOnlar\tO\tPRON\t_\tCase=Nom|Number=Plur|Person=3|PronType=Prs\t_\t_\t_\t_\n" +
+		"2\t3\t3\tNUM\t_\t_\t_\t_\t_\t_\n" +
+		"3\tev\tev\tNOUN\t_\tCase=Nom|Number=Sing\t_\t_\t_\t_\n" +
+		"4\taldılar\talmaq\tVERB\t_\tEvident=Fh|Mood=Ind|Number=Plur|Person=3|Polarity=Pos|Tense=Past|VerbForm=Fin\t_\t_\t_\tSpaceAfter=No\n" +
+		"5\t!\t!\tPUNCT\t_\t_\t_\t_\t_\t_\n" +
+		"\n"
+	if got := sb.String(); got != want {
+		t.Errorf("WriteCoNLLU =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteCoNLLUClosedClassAndApostrophe(t *testing.T) {
+	var sb strings.Builder
+	if err := WriteCoNLLU(&sb, "Bakı'nın və Gəncə’də ilə də"); err != nil {
+		t.Fatal(err)
+	}
+	want := "# sent_id = 1\n" +
+		"# text = Bakı'nın və Gəncə’də ilə də\n" +
+		"1\tBakı'nın\tBakı\tNOUN\t_\tCase=Gen|Number=Sing\t_\t_\t_\t_\n" +
+		"2\tvə\tvə\tCCONJ\t_\t_\t_\t_\t_\t_\n" +
+		"3\tGəncə’də\tGəncə\tNOUN\t_\tCase=Loc|Number=Sing\t_\t_\t_\t_\n" +
+		"4\tilə\tilə\tADP\t_\t_\t_\t_\t_\t_\n" +
+		"5\tdə\tdə\tPART\t_\t_\t_\t_\t_\t_\n" +
+		"\n"
+	if got := sb.String(); got != want {
+		t.Errorf("WriteCoNLLU =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteCoNLLUEdgeCases(t *testing.T) {
+	for _, text := range []string{"", "   ", "\n\n"} {
+		var sb strings.Builder
+		if err := WriteCoNLLU(&sb, text); err != nil || sb.Len() != 0 {
+			t.Errorf("WriteCoNLLU(%q) = %q, %v, want empty", text, sb.String(), err)
+		}
+	}
+}
+
+// failWriter fails every write.
+type failWriter struct{}
+
+func (failWriter) Write([]byte) (int, error) { return 0, errors.New("write failed") }
+
+func TestWriteCoNLLUError(t *testing.T) {
+	if err := WriteCoNLLU(failWriter{}, "Salam."); err == nil {
+		t.Error("WriteCoNLLU to a failing writer returned nil error")
+	}
+}
+
+func ExampleWriteCoNLLU() {
+	_ = WriteCoNLLU(os.Stdout, "Evlərdə.")
+	// Output:
+	// # sent_id = 1
+	// # text = Evlərdə.
+	// 1	Evlərdə	Ev	NOUN	_	Case=Loc|Number=Plur	_	_	_	SpaceAfter=No
+	// 2	.	.	PUNCT	_	_	_	_	_	_
+}
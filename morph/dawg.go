@@ -0,0 +1,160 @@
+// Stem dictionary automaton for Azerbaijani morphological analysis.
+//
+// The stems of data/dict.txt are embedded as a minimal acyclic automaton
+// (data/dict.dawg) generated by cmd/dictgen. Prefix walks run on the
+// embedded bytes in place. Shared suffixes of the stems are stored once,
+// which makes the automaton smaller than the text file. Membership tests,
+// which the walker makes at every step, go to stemIndex, a map filled from
+// the automaton at startup.
+//
+// A node is a run of little-endian uint32 edges sorted by label; the last
+// edge of a run has dawgLastBit set. An edge holds its label byte in the
+// low 8 bits and the index of its target node's first edge above
+// dawgTargetShift. An edge labelled 0 marks the end of a stem and holds
+// the stem's POS byte in place of a target.
+package morph
+
+import (
+	"encoding/binary"
+
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// Automaton layout constants, shared with cmd/dictgen.
+const (
+	dawgMagic       = "AZDW"
+	dawgHeaderLen   = 8
+	dawgLastBit     = 1 << 8
+	dawgTargetShift = 9
+)
+
+// dawg is a stem automaton in the cmd/dictgen format.
+type dawg struct {
+	edges []byte // edge array, 4 bytes per edge
+	root  uint32 // index of the root node's first edge
+}
+
+// stemDAWG is the embedded stem dictionary.
+var stemDAWG = loadDAWG(data.MorphDictDAWG)
+
+// stemIndex maps every stem of stemDAWG to its POS byte. A map lookup is
+// several times faster than a walk of the automaton.
+var stemIndex = stemDAWG.index()
+
+// loadDAWG validates the header of an automaton and returns it.
+// Panics on a malformed file, which is a build error.
+func loadDAWG(b []byte) dawg {
+	if len(b) < dawgHeaderLen || string(b[:4]) != dawgMagic || (len(b)-dawgHeaderLen)%4 != 0 {
+		panic("morph: malformed stem automaton")
+	}
+	d := dawg{edges: b[dawgHeaderLen:], root: binary.LittleEndian.Uint32(b[4:])}
+	if int(d.root) >= d.len() {
+		panic("morph: malformed stem automaton")
+	}
+	return d
+}
+
+// len returns the number of edges.
+func (d dawg) len() int {
+	return len(d.edges) / 4
+}
+
+// edge returns the edge at index i.
+func (d dawg) edge(i uint32) uint32 {
+	return binary.LittleEndian.Uint32(d.edges[4*i:])
+}
+
+// next returns the target of the edge labelled c leaving the node that
+// starts at edge index node, or false if there is none.
+func (d dawg) next(node uint32, c byte) (uint32, bool) {
+	for i := node; ; i++ {
+		e := d.edge(i)
+		if l := byte(e); l == c {
+			return e >> dawgTargetShift, true
+		} else if l > c || e&dawgLastBit != 0 {
+			return 0, false
+		}
+	}
+}
+
+// walk follows s from the root and returns the node it ends in.
+func (d dawg) walk(s string) (uint32, bool) {
+	node := d.root
+	for i := 0; i < len(s); i++ {
+		if s[i] == 0 {
+			return 0, false
+		}
+		var ok bool
+		if node, ok = d.next(node, s[i]); !ok {
+			return 0, false
+		}
+	}
+	return node, true
+}
+
+// lookup returns the POS byte of stem s, or 0 if s is not in the automaton.
+func (d dawg) lookup(s string) byte {
+	node, ok := d.walk(s)
+	if !ok {
+		return 0
+	}
+	// The end marker sorts first in its run.
+	if e := d.edge(node); byte(e) == 0 {
+		return byte(e >> dawgTargetShift)
+	}
+	return 0
+}
+
+// index returns a map from every stem to its POS byte.
+func (d dawg) index() map[string]byte {
+	m := make(map[string]byte, d.len()/2)
+	d.withPrefix("", func(stem string, pos byte) {
+		m[stem] = pos
+	})
+	return m
+}
+
+// withPrefix calls fn for every stem that starts with prefix, in byte
+// order.
+func (d dawg) withPrefix(prefix string, fn func(stem string, pos byte)) {
+	node, ok := d.walk(prefix)
+	if !ok {
+		return
+	}
+	buf := []byte(prefix)
+	var visit func(node uint32)
+	visit = func(node uint32) {
+		for i := node; ; i++ {
+			e := d.edge(i)
+			if c := byte(e); c == 0 {
+				fn(string(buf), byte(e>>dawgTargetShift))
+			} else {
+				buf = append(buf, c)
+				visit(e >> dawgTargetShift)
+				buf = buf[:len(buf)-1]
+			}
+			if e&dawgLastBit != 0 {
+				return
+			}
+		}
+	}
+	visit(node)
+}
+
+// prefixesOf calls fn with the byte length of every stem that is a
+// non-empty prefix of s, shortest first.
+func (d dawg) prefixesOf(s string, fn func(n int, pos byte)) {
+	node := d.root
+	for i := 0; ; i++ {
+		if e := d.edge(node); byte(e) == 0 && i > 0 {
+			fn(i, byte(e>>dawgTargetShift))
+		}
+		if i == len(s) || s[i] == 0 {
+			return
+		}
+		var ok bool
+		if node, ok = d.next(node, s[i]); !ok {
+			return
+		}
+	}
+}
@@ -1,64 +1,94 @@
 package morph
 
 import (
-	"bytes"
-
-	"github.com/az-ai-labs/az-lang-nlp/data"
-)
-
-// minLineLen is the minimum valid line length in dict.txt:
-// one byte for the POS tag plus at least one character for the lemma.
-const minLineLen = 2
-
-// Parsed dictionary data, populated by init().
-var (
-	dictLemmas []string        // sorted lemmas, kept for test integrity checks
-	dictMap    map[string]byte // stem -> POS byte for O(1) lookups
+	"slices"
+	"strings"
 )
 
-func init() {
-	// Parse dictRaw: each line is <POS_byte><lemma>\n
-	// These lookups are for soft ranking in fsm.go walk() base case,
-	// not as hard filters — an unknown stem does not block analysis.
-	lines := bytes.Split(data.MorphDict, []byte("\n"))
-	dictMap = make(map[string]byte, len(lines))
-	dictLemmas = make([]string, 0, len(lines))
-	for _, line := range lines {
-		if len(line) < minLineLen {
-			continue
-		}
-		lemma := string(line[1:])
-		if _, exists := dictMap[lemma]; !exists {
-			dictMap[lemma] = line[0]
-		}
-		dictLemmas = append(dictLemmas, lemma)
-	}
-}
+// The stem dictionary is the embedded automaton stemDAWG, indexed by
+// stemIndex (see dawg.go). Its lookups are for soft ranking in fsm.go walk() base case, not as
+// hard filters — an unknown stem does not block analysis.
 
 // IsKnownStem reports whether s is a known dictionary stem.
 // Expects lowercase Azerbaijani Latin input.
 // Results may change as the dictionary grows.
 func IsKnownStem(s string) bool {
-	return isKnownStem(s)
+	return defaultAnalyzer.isKnownStem(s)
 }
 
 // isKnownStem reports whether s is a known dictionary stem.
 // Expects lowercase Latin input.
 func isKnownStem(s string) bool {
-	if s == "" {
-		return false
-	}
-	_, ok := dictMap[s]
-	return ok
+	return stemPOS(s) != 0
 }
 
 // stemPOS returns the POS byte for a known stem, or 0 if not found.
 // Expects lowercase Latin input.
-//
-//nolint:unused // called from tests; integrated into FSM in PR #2
 func stemPOS(s string) byte {
 	if s == "" {
 		return 0
 	}
-	return dictMap[s]
+	return stemIndex[s]
+}
+
+// KnownStemsWithPrefix returns the known stems that start with prefix,
+// in byte order. An empty prefix enumerates the whole dictionary.
+// Expects lowercase Azerbaijani Latin input.
+func KnownStemsWithPrefix(prefix string) []string {
+	return defaultAnalyzer.KnownStemsWithPrefix(prefix)
+}
+
+// KnownStemsWithPrefix is like the package-level KnownStemsWithPrefix but
+// adds the Analyzer's extra stems and leaves out blocked ones.
+func (an *Analyzer) KnownStemsWithPrefix(prefix string) []string {
+	var out []string
+	stemDAWG.withPrefix(prefix, func(s string, _ byte) {
+		if !an.isBlocked(s) {
+			out = append(out, s)
+		}
+	})
+	extra := len(out)
+	for s := range an.stems {
+		if strings.HasPrefix(s, prefix) && !an.isBlocked(s) {
+			out = append(out, s)
+		}
+	}
+	for _, d := range pronounDecls {
+		if strings.HasPrefix(d.lemma, prefix) && !an.isBlocked(d.lemma) {
+			out = append(out, d.lemma)
+		}
+	}
+	if len(out) > extra {
+		slices.Sort(out)
+		out = slices.Compact(out)
+	}
+	return out
+}
+
+// LongestKnownStem returns the longest known stem that is a prefix of
+// word, or "" if there is none. Expects lowercase Azerbaijani Latin input.
+func LongestKnownStem(word string) string {
+	return defaultAnalyzer.LongestKnownStem(word)
+}
+
+// LongestKnownStem is like the package-level LongestKnownStem but
+// consults the Analyzer's lexicon.
+func (an *Analyzer) LongestKnownStem(word string) string {
+	var best string
+	stemDAWG.prefixesOf(word, func(n int, _ byte) {
+		if !an.isBlocked(word[:n]) {
+			best = word[:n]
+		}
+	})
+	for s := range an.stems {
+		if len(s) > len(best) && strings.HasPrefix(word, s) && !an.isBlocked(s) {
+			best = s
+		}
+	}
+	for _, d := range pronounDecls {
+		if len(d.lemma) > len(best) && strings.HasPrefix(word, d.lemma) && !an.isBlocked(d.lemma) {
+			best = d.lemma
+		}
+	}
+	return best
 }
@@ -1,7 +1,13 @@
 package morph
 
 import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
 	"sort"
+	"strings"
 	"testing"
 )
 
@@ -58,13 +64,163 @@ func TestStemPOS(t *testing.T) {
 	}
 }
 
+func TestLookupPOS(t *testing.T) {
+	tests := []struct {
+		input string
+		want  POS
+	}{
+		{"kitab", POSNoun},
+		{"gəl", POSVerb},
+		{"gözəl", POSAdj},
+		{"çox", POSAdv},
+		{"mən", POSPronoun},
+		{"o", POSPronoun},
+		{"xyznotfound", POSUnknown},
+		{"", POSUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := LookupPOS(tt.input); got != tt.want {
+				t.Errorf("LookupPOS(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPOSJSON(t *testing.T) {
+	for p := POSUnknown; p <= POSPronoun; p++ {
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", p, err)
+		}
+		var got POS
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if got != p {
+			t.Errorf("round-trip %v: got %v", p, got)
+		}
+	}
+	var p POS
+	if err := json.Unmarshal([]byte(`"Bogus"`), &p); err == nil {
+		t.Error("Unmarshal(\"Bogus\") should return error")
+	}
+	if s := POS(99).String(); s != "POS(99)" {
+		t.Errorf("POS(99).String() = %q, want %q", s, "POS(99)")
+	}
+}
+
 func TestDictIntegrity(t *testing.T) {
 	const minEntries = 10000
-	if len(dictLemmas) < minEntries {
-		t.Fatalf("dictionary has %d entries, want at least %d", len(dictLemmas), minEntries)
+	// The automaton must hold exactly the stems of dict.txt; a mismatch
+	// means data/dict.dawg is stale (run go generate ./data).
+	dict, err := os.ReadFile("../data/dict.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	wantPOS := make(map[string]byte)
+	for _, line := range bytes.Split(dict, []byte("\n")) {
+		if len(line) < 2 {
+			continue
+		}
+		lemma := string(line[1:])
+		if _, ok := wantPOS[lemma]; !ok {
+			wantPOS[lemma] = line[0]
+			want = append(want, lemma)
+		}
+	}
+	var got []string
+	stemDAWG.withPrefix("", func(s string, _ byte) {
+		got = append(got, s)
+	})
+	if len(got) < minEntries {
+		t.Fatalf("dictionary has %d entries, want at least %d", len(got), minEntries)
+	}
+	if !sort.StringsAreSorted(got) {
+		t.Fatal("dictionary stems are not sorted")
+	}
+	if !slices.Equal(got, want) {
+		t.Fatalf("automaton has %d stems, dict.txt %d: data/dict.dawg is stale", len(got), len(want))
+	}
+	if len(stemIndex) != len(want) {
+		t.Fatalf("stemIndex has %d stems, want %d", len(stemIndex), len(want))
+	}
+	for lemma, pos := range wantPOS {
+		if p := stemPOS(lemma); p != pos {
+			t.Fatalf("stemPOS(%q) = %q, want %q", lemma, p, pos)
+		}
+		if p := stemDAWG.lookup(lemma); p != pos {
+			t.Fatalf("stemDAWG.lookup(%q) = %q, want %q", lemma, p, pos)
+		}
+	}
+}
+
+func TestKnownStemsWithPrefix(t *testing.T) {
+	got := KnownStemsWithPrefix("kitab")
+	for _, s := range []string{"kitab", "kitabxana"} {
+		if !slices.Contains(got, s) {
+			t.Errorf("KnownStemsWithPrefix(kitab) = %v, missing %q", got, s)
+		}
+	}
+	for _, s := range got {
+		if !strings.HasPrefix(s, "kitab") {
+			t.Errorf("KnownStemsWithPrefix(kitab) returned %q", s)
+		}
 	}
-	if !sort.StringsAreSorted(dictLemmas) {
-		t.Fatal("dictLemmas is not sorted")
+	if got := KnownStemsWithPrefix("xyznotfound"); got != nil {
+		t.Errorf("KnownStemsWithPrefix(xyznotfound) = %v, want nil", got)
+	}
+
+	an := NewAnalyzer(Options{Stems: map[string]POS{"kitabçıq": POSNoun}, Blocked: []string{"kitab"}})
+	got = an.KnownStemsWithPrefix("kitab")
+	if slices.Contains(got, "kitab") || !slices.Contains(got, "kitabçıq") || !sort.StringsAreSorted(got) {
+		t.Errorf("Analyzer.KnownStemsWithPrefix(kitab) = %v", got)
+	}
+	if got := an.KnownStemsWithPrefix("mə"); !slices.Contains(got, "mən") {
+		t.Errorf("Analyzer.KnownStemsWithPrefix(mə) = %v, missing pronoun mən", got)
+	}
+}
+
+func TestLongestKnownStem(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"kitablarımızdan", "kitab"},
+		{"kitabxanada", "kitabxana"},
+		{"gözəllikdə", "gözəllik"},
+		{"kitab", "kitab"},
+		{"xyz", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := LongestKnownStem(tt.input); got != tt.want {
+				t.Errorf("LongestKnownStem(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	an := NewAnalyzer(Options{Stems: map[string]POS{"kitablar": POSNoun}, Blocked: []string{"kitabxana"}})
+	if got := an.LongestKnownStem("kitablarımızdan"); got != "kitablar" {
+		t.Errorf("Analyzer.LongestKnownStem(kitablarımızdan) = %q, want kitablar", got)
+	}
+	if got := an.LongestKnownStem("kitabxanada"); got != "kitab" {
+		t.Errorf("Analyzer.LongestKnownStem(kitabxanada) = %q, want kitab", got)
+	}
+}
+
+func TestLoadDAWGMalformed(t *testing.T) {
+	for _, b := range [][]byte{nil, []byte("AZDW"), []byte("XXXX\x00\x00\x00\x00"), []byte("AZDW\x05\x00\x00\x00\x00\x00\x00\x00")} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("loadDAWG(%q) did not panic", b)
+				}
+			}()
+			loadDAWG(b)
+		}()
 	}
 }
 
@@ -73,3 +229,14 @@ func BenchmarkIsKnownStem(b *testing.B) {
 		isKnownStem("kitab")
 	}
 }
+
+func BenchmarkKnownStemsWithPrefix(b *testing.B) {
+	for b.Loop() {
+		KnownStemsWithPrefix("ki")
+	}
+}
+
+func ExampleLongestKnownStem() {
+	fmt.Println(LongestKnownStem("kitabxanalarda"))
+	// Output: kitabxana
+}
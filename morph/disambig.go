@@ -0,0 +1,231 @@
+// Context-aware disambiguation for Azerbaijani morphological analysis.
+//
+// AnalyzeSentence picks one analysis per word by maximising a score over
+// the whole sentence with a Viterbi pass. Each candidate gets a prior from
+// its rank in Analyze and from agreement with Stem, and each pair of
+// neighbouring candidates is scored by hand-written constraint rules over
+// their MorphTag sequences (genitive–possessive izafet, case-marked object
+// before a verb, no two adjacent finite verbs).
+package morph
+
+import "github.com/az-ai-labs/az-lang-nlp/azcase"
+
+// maxCandidates caps the analyses considered per word during
+// disambiguation, keeping the Viterbi pass at O(n·k²).
+const maxCandidates = 16
+
+// Prior weights for a single candidate.
+const (
+	rankPenalty      = 0.1 // per position in Analyze order
+	stemPickBonus    = 1.0 // candidate stem equals Stem(word)
+	finiteFinalBonus = 1.0 // finite verb or copula predicate in sentence-final position
+	agreementBonus   = 1.5 // person suffix agrees with a pronoun subject or possessor
+)
+
+// constraint scores a pair of analyses of adjacent words.
+type constraint struct {
+	name   string
+	weight float64
+	match  func(prev, cur Analysis) bool
+}
+
+// constraints is the bigram rule table applied by AnalyzeSentence.
+var constraints = []constraint{
+	// Izafet: a genitive is followed by a 3rd person possessive
+	// (kitabın səhifəsi, not kitabın səhifəs+i).
+	{"genitive-possessed", 1.5, func(prev, cur Analysis) bool {
+		return lastTag(prev) == CaseGen && (hasTag(cur, Poss3Sg) || hasTag(cur, Poss3Pl))
+	}},
+	// A case-marked object precedes its verb (dəftəri aldım: accusative,
+	// not possessive).
+	{"object-verb", 0.75, func(prev, cur Analysis) bool {
+		switch lastTag(prev) {
+		case CaseAcc, CaseDat, CaseAbl, CaseLoc, CaseIns:
+			return isVerbal(cur)
+		}
+		return false
+	}},
+	// Participles and derived adjectives modify a following noun.
+	{"modifier-noun", 0.5, func(prev, cur Analysis) bool {
+		switch lastTag(prev) {
+		case Participle, ParticipleAdj, DerivPoss, DerivPriv:
+			return !isVerbal(cur)
+		}
+		return false
+	}},
+	// Two finite verbs rarely stand next to each other.
+	{"finite-finite", -1.0, func(prev, cur Analysis) bool {
+		return isFinite(prev) && isFinite(cur)
+	}},
+}
+
+// pronounSubjects maps nominative personal pronouns to the person suffix
+// of the verb they agree with.
+var pronounSubjects = map[string]MorphTag{
+	"mən":   Pers1Sg,
+	"sən":   Pers2Sg,
+	"biz":   Pers1Pl,
+	"siz":   Pers2Pl,
+	"onlar": Pers3,
+}
+
+// pronounPossessors maps genitive personal pronouns to the possessive
+// suffix of the noun they govern.
+var pronounPossessors = map[string]MorphTag{
+	"mənim":   Poss1Sg,
+	"sənin":   Poss2Sg,
+	"onun":    Poss3Sg,
+	"bizim":   Poss1Pl,
+	"sizin":   Poss2Pl,
+	"onların": Poss3Sg,
+}
+
+// AnalyzeSentence returns one analysis per word, chosen using the
+// neighbouring words. Designed to be used with tokenizer.Words().
+//
+// Candidates for each word come from Analyze. The selected sequence
+// maximises the sum of per-word priors (Analyze rank, agreement with
+// Stem, sentence-final finite verb, agreement with a pronoun subject or
+// possessor) and pairwise constraint scores between adjacent words.
+//
+// Returns nil if words is nil. The result has the same length as words;
+// an empty word yields an empty Analysis.
+func AnalyzeSentence(words []string) []Analysis {
+	return defaultAnalyzer.AnalyzeSentence(words)
+}
+
+// AnalyzeSentence is like the package-level AnalyzeSentence but draws
+// candidates from the Analyzer's Analyze and Stem.
+func (an *Analyzer) AnalyzeSentence(words []string) []Analysis {
+	if words == nil {
+		return nil
+	}
+	n := len(words)
+	out := make([]Analysis, n)
+	if n == 0 {
+		return out
+	}
+
+	// Per-word candidates and priors.
+	cands := make([][]Analysis, n)
+	priors := make([][]float64, n)
+	var subject, possessor MorphTag
+	for i, w := range words {
+		cs, stem := an.analyzeStem(w)
+		if len(cs) == 0 {
+			cs = []Analysis{{Stem: w}}
+		}
+		if len(cs) > maxCandidates {
+			cs = cs[:maxCandidates]
+		}
+		cands[i] = cs
+
+		ps := make([]float64, len(cs))
+		for j, a := range cs {
+			ps[j] = -rankPenalty * float64(j)
+			if a.Stem == stem {
+				ps[j] += stemPickBonus
+			}
+			if i == n-1 && isFinite(a) {
+				ps[j] += finiteFinalBonus
+			}
+			if subject != 0 && isFinite(a) && hasTag(a, subject) {
+				ps[j] += agreementBonus
+			}
+			if possessor != 0 && hasTag(a, possessor) {
+				ps[j] += agreementBonus
+			}
+		}
+		priors[i] = ps
+
+		low := azcase.ToLower(w)
+		if p, ok := pronounSubjects[low]; ok {
+			subject = p
+		}
+		possessor = pronounPossessors[low] // governs the next word only
+	}
+
+	// Viterbi over candidate sequences. Ties keep the earlier candidate,
+	// so with no contextual evidence the Analyze order is preserved.
+	score := priors[0]
+	back := make([][]int, n)
+	for i := 1; i < n; i++ {
+		next := make([]float64, len(cands[i]))
+		back[i] = make([]int, len(cands[i]))
+		for j, cur := range cands[i] {
+			best, arg := 0.0, -1
+			for k, prev := range cands[i-1] {
+				s := score[k] + pairScore(prev, cur)
+				if arg < 0 || s > best {
+					best, arg = s, k
+				}
+			}
+			next[j] = best + priors[i][j]
+			back[i][j] = arg
+		}
+		score = next
+	}
+
+	arg := 0
+	for j := range score {
+		if score[j] > score[arg] {
+			arg = j
+		}
+	}
+	for i := n - 1; i >= 0; i-- {
+		out[i] = cands[i][arg]
+		if i > 0 {
+			arg = back[i][arg]
+		}
+	}
+	return out
+}
+
+// pairScore sums the weights of all constraints matched by prev and cur.
+func pairScore(prev, cur Analysis) float64 {
+	var s float64
+	for i := range constraints {
+		if constraints[i].match(prev, cur) {
+			s += constraints[i].weight
+		}
+	}
+	return s
+}
+
+// lastTag returns the tag of the outermost morpheme, or 0 for a bare stem.
+func lastTag(a Analysis) MorphTag {
+	if len(a.Morphemes) == 0 {
+		return 0
+	}
+	return a.Morphemes[len(a.Morphemes)-1].Tag
+}
+
+// hasTag reports whether any morpheme of a carries tag.
+func hasTag(a Analysis, tag MorphTag) bool {
+	for _, m := range a.Morphemes {
+		if m.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// isFinite reports whether a ends in a finite predicate marker: a tense,
+// the obligative or conditional mood, a person suffix, the copula, or the
+// question particle after one of those. Participles and converbs are not
+// finite.
+func isFinite(a Analysis) bool {
+	tag := lastTag(a)
+	if tag == Question && len(a.Morphemes) > 1 {
+		tag = a.Morphemes[len(a.Morphemes)-2].Tag
+	}
+	switch {
+	case tag >= TensePastDef && tag <= TensePastEvi:
+		return true
+	case tag == MoodOblig || tag == MoodCond:
+		return true
+	case isPersonTag(tag) || tag == Copula:
+		return true
+	}
+	return false
+}
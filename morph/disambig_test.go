@@ -0,0 +1,128 @@
+package morph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAnalyzeSentence(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		want  []string // Analysis.String() per word
+	}{
+		{
+			"genitive selects possessive",
+			[]string{"kitabın", "dəftəri"},
+			[]string{"kitab[CaseGen:ın]", "dəftər[Poss3Sg:i]"},
+		},
+		{
+			"verb selects accusative",
+			[]string{"dəftəri", "aldım"},
+			[]string{"dəftər[CaseAcc:i]", "al[TensePastDef:dı|Pers1Sg:m]"},
+		},
+		{
+			"izafet",
+			[]string{"kitabın", "səhifəsi"},
+			[]string{"kitab[CaseGen:ın]", "səhifə[Poss3Sg:si]"},
+		},
+		{
+			"pronoun possessor",
+			[]string{"sənin", "kitabın"},
+			[]string{"sən[CaseGen:in]", "kitab[Poss2Sg:ın]"},
+		},
+		{
+			"pronoun subject agreement",
+			[]string{"Sən", "kitab", "oxuyursan"},
+			[]string{"Sən", "kitab", "oxuy[TensePresent:ur|Pers2Sg:san]"},
+		},
+		{
+			"participle modifies noun",
+			[]string{"gələn", "adam"},
+			[]string{"gəl[Participle:ən]", "adam"},
+		},
+		{
+			"dative object before verb",
+			[]string{"Mən", "evə", "getdim"},
+			[]string{"Mən", "ev[CaseDat:ə]", "get[TensePastDef:di|Pers1Sg:m]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AnalyzeSentence(tt.words)
+			if len(got) != len(tt.want) {
+				t.Fatalf("AnalyzeSentence(%v) returned %d analyses, want %d", tt.words, len(got), len(tt.want))
+			}
+			for i := range got {
+				if got[i].String() != tt.want[i] {
+					t.Errorf("word %d %q: got %v, want %s", i, tt.words[i], got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// TestAnalyzeSentenceSingleWord checks that without context the first
+// analysis from Analyze is kept.
+func TestAnalyzeSentenceSingleWord(t *testing.T) {
+	for _, w := range []string{"kitablarımızdan", "dəftəri", "kitabın", "oxuyursan", "gəlmişdir"} {
+		got := AnalyzeSentence([]string{w})
+		want := Analyze(w)[0]
+		if got[0].String() != want.String() {
+			t.Errorf("AnalyzeSentence([%q]) = %v, want %v", w, got[0], want)
+		}
+	}
+}
+
+func TestAnalyzeSentenceEdgeCases(t *testing.T) {
+	if got := AnalyzeSentence(nil); got != nil {
+		t.Errorf("AnalyzeSentence(nil) = %v, want nil", got)
+	}
+	if got := AnalyzeSentence([]string{}); got == nil || len(got) != 0 {
+		t.Errorf("AnalyzeSentence([]) = %v, want empty non-nil", got)
+	}
+	got := AnalyzeSentence([]string{"kitab", "", "evə"})
+	if len(got) != 3 || got[1].Stem != "" || len(got[1].Morphemes) != 0 {
+		t.Errorf("AnalyzeSentence with empty word = %v", got)
+	}
+}
+
+func TestIsFinite(t *testing.T) {
+	tests := []struct {
+		word string
+		stem string
+		tags []MorphTag
+		want bool
+	}{
+		{"gəldim", "gəl", []MorphTag{TensePastDef, Pers1Sg}, true},
+		{"gəlir", "gəl", []MorphTag{TensePresent}, true},
+		{"gəlmişdir", "gəl", []MorphTag{TensePastIndef, Copula}, true},
+		{"gəldimi", "gəl", []MorphTag{TensePastDef, Question}, true},
+		{"gələn", "gəl", []MorphTag{Participle}, false},
+		{"evdə", "ev", []MorphTag{CaseLoc}, false},
+		{"ev", "ev", nil, false},
+	}
+	for _, tt := range tests {
+		a := Analysis{Stem: tt.stem, Morphemes: toMorphemes(tt.tags)}
+		if got := isFinite(a); got != tt.want {
+			t.Errorf("isFinite(%s) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+}
+
+func BenchmarkAnalyzeSentence(b *testing.B) {
+	words := []string{"Mən", "kitabın", "səhifəsini", "oxuyuram"}
+	for b.Loop() {
+		AnalyzeSentence(words)
+	}
+}
+
+func ExampleAnalyzeSentence() {
+	for _, a := range AnalyzeSentence([]string{"kitabın", "dəftəri"}) {
+		fmt.Println(a)
+	}
+	// Output:
+	// kitab[CaseGen:ın]
+	// dəftər[Poss3Sg:i]
+}
@@ -2,6 +2,7 @@ package morph
 
 import (
 	"sort"
+	"unicode/utf8"
 
 	"github.com/az-ai-labs/az-lang-nlp/azcase"
 )
@@ -14,6 +15,7 @@ const maxAnalyses = 128
 
 // walker holds the state for a single backtracking morphological analysis run.
 type walker struct {
+	an         *Analyzer  // lexicon consulted for blocked stems
 	origRunes  []rune     // original-cased word as runes
 	lowerRunes []rune     // lowercased word as runes
 	results    []Analysis // accumulated analyses
@@ -33,6 +35,8 @@ func init() {
 	for i := range suffixRules {
 		seen[suffixRules[i].toState] = true
 	}
+	// verbAfterRelPart stays terminal: a bare relative participle is a
+	// valid noun modifier (gələcək nəsil, oxunmadıq kitab).
 	terminalStates = make([]fsmState, 0, len(seen))
 	for s := range seen {
 		terminalStates = append(terminalStates, s)
@@ -62,12 +66,13 @@ func init() {
 
 // analyze performs morphological analysis on word, returning all valid parses
 // sorted by morpheme count descending (deepest analysis first), deduplicated.
-func analyze(word string) []Analysis {
+func (an *Analyzer) analyze(word string) []Analysis {
 	low := azcase.ToLower(word)
 	origRunes := []rune(word)
 	lowerRunes := []rune(low)
 
 	w := &walker{
+		an:         an,
 		origRunes:  origRunes,
 		lowerRunes: lowerRunes,
 	}
@@ -88,40 +93,53 @@ func analyze(word string) []Analysis {
 	// Among known stems, prefer longer stems (less stripping) and simpler
 	// analyses (fewer morphemes) — Occam's razor. Among unknown stems,
 	// prefer shorter stems (deeper stripping found the real root), then
-	// simpler analyses (fewer morphemes) for same-length stems.
-	sort.Slice(w.results, func(i, j int) bool {
-		ki := isKnownStem(azcase.ToLower(w.results[i].Stem))
-		kj := isKnownStem(azcase.ToLower(w.results[j].Stem))
+	// simpler analyses (fewer morphemes) for same-length stems. A bare
+	// relative participle (gələcək, yazdıq) ranks after the finite reading
+	// of the same stem, which is the more common one.
+	// The keys are computed once per analysis, not once per comparison.
+	type keyed struct {
+		a     Analysis
+		known bool
+		runes int
+		bare  bool
+	}
+	ks := make([]keyed, len(w.results))
+	for i, a := range w.results {
+		ks[i] = keyed{a, an.isKnownStem(azcase.ToLower(a.Stem)), utf8.RuneCountInString(a.Stem), isBareRelPart(a)}
+	}
+	sort.Slice(ks, func(i, j int) bool {
+		ki, kj := ks[i].known, ks[j].known
 		if ki != kj {
 			return ki
 		}
-		si, sj := len([]rune(w.results[i].Stem)), len([]rune(w.results[j].Stem))
-		if ki && kj {
-			// Both known: prefer longer stem (less aggressive stripping).
-			if si != sj {
-				return si > sj
-			}
-			// Same-length known stems: prefer fewer morphemes (simpler parse).
-			mi, mj := len(w.results[i].Morphemes), len(w.results[j].Morphemes)
-			if mi != mj {
-				return mi < mj
-			}
-		} else {
-			// Unknown stems: prefer shorter stem (deeper stripping), then
-			// simpler parse (fewer morphemes) for same-length stems.
-			if si != sj {
-				return si < sj
-			}
-			mi, mj := len(w.results[i].Morphemes), len(w.results[j].Morphemes)
-			if mi != mj {
-				return mi < mj
-			}
+		if si, sj := ks[i].runes, ks[j].runes; si != sj {
+			// Known: prefer longer stem (less aggressive stripping).
+			// Unknown: prefer shorter stem (deeper stripping).
+			return si > sj == ki
 		}
-		return tagsKey(w.results[i].Morphemes) < tagsKey(w.results[j].Morphemes)
+		if bi, bj := ks[i].bare, ks[j].bare; bi != bj {
+			return bj
+		}
+		// Same-length stems: prefer fewer morphemes (simpler parse).
+		mi, mj := len(ks[i].a.Morphemes), len(ks[j].a.Morphemes)
+		if mi != mj {
+			return mi < mj
+		}
+		return tagsKey(ks[i].a.Morphemes) < tagsKey(ks[j].a.Morphemes)
 	})
+	for i := range ks {
+		w.results[i] = ks[i].a
+	}
 	return w.results
 }
 
+// isBareRelPart reports whether a ends in a relative participle with no
+// possessive after it.
+func isBareRelPart(a Analysis) bool {
+	n := len(a.Morphemes)
+	return n > 0 && (a.Morphemes[n-1].Tag == ParticiplePast || a.Morphemes[n-1].Tag == ParticipleFuture)
+}
+
 // walk recursively strips suffixes from the right, building morpheme chains.
 // pos is a rune index: runes [0..pos) are the remaining candidate stem.
 // state is the expected toState of the next suffix to strip (going right-to-left).
@@ -133,7 +151,10 @@ func (w *walker) walk(pos int, state fsmState, morphemes []Morpheme, depth int)
 
 	// Base case: traced back to initial → check stem validity.
 	if state == initial {
-		if pos > 0 && isValidStem(string(w.lowerRunes[:pos])) {
+		if pos == 0 {
+			return
+		}
+		if low := string(w.lowerRunes[:pos]); w.validStem(low) && w.baseFits(low, morphemes) {
 			w.results = append(w.results, Analysis{
 				Stem:      string(w.origRunes[:pos]),
 				Morphemes: cloneMorphemes(morphemes),
@@ -171,7 +192,7 @@ func (w *walker) walk(pos int, state fsmState, morphemes []Morpheme, depth int)
 
 			// Vowel harmony validation against the remaining stem AFTER stripping.
 			stemPart := string(w.lowerRunes[:stemEnd])
-			stemLV := lastVowel(stemPart)
+			stemLV := harmonyVowel(stemPart)
 			suffFV := firstVowel(surface)
 
 			switch rule.harmony {
@@ -209,6 +230,11 @@ func (w *walker) walk(pos int, state fsmState, morphemes []Morpheme, depth int)
 
 			// Recurse into each valid predecessor state.
 			for _, fromState := range rule.fromStates {
+				// Relative participles end in q/k, so only the post-consonant
+				// possessive allomorphs may follow (yazdığım, not yazdıqm).
+				if fromState == verbAfterRelPart && !fitsContext(rule.tag, surfRunes, false, 0) {
+					continue
+				}
 				w.walk(stemEnd, fromState, newMorphemes, depth+1)
 
 				// k/q softening: if suffix starts with a vowel and the stem
@@ -239,12 +265,52 @@ func (w *walker) tryRestoredStem(newPos int, restoredRune rune, state fsmState,
 	w.lowerRunes[idx] = restoredRune
 	w.origRunes[idx] = restoredRune
 
-	w.walk(newPos, state, morphemes, depth+1)
+	// Listed loanwords keep their stop (iştirakı), so iştirayı is not
+	// iştirak+ı.
+	if !isHarmonyException(string(w.lowerRunes[:newPos])) {
+		w.walk(newPos, state, morphemes, depth+1)
+	}
 
 	w.lowerRunes[idx] = savedLower
 	w.origRunes[idx] = savedOrig
 }
 
+// validStem reports whether the lowercase candidate s may end the walk:
+// it must be phonotactically valid and not blocked by the Analyzer.
+func (w *walker) validStem(s string) bool {
+	return isValidStem(s) && !w.an.isBlocked(s)
+}
+
+// baseFits reports whether the lowercase stem s may carry the first suffix
+// of morphemes. The vowelless causative -t follows only vowel-final verb
+// stems (oxut, işlət), as in generate.go. Derivations whose surfaces also
+// end many unrelated words (masal, konstan, koca, sonucu) need a known
+// base: a noun for -lan and -sal, a verb for the agent -ıcı, and a
+// nominal dictionary stem for the others.
+func (w *walker) baseFits(s string, morphemes []Morpheme) bool {
+	if len(morphemes) == 0 {
+		return true
+	}
+	switch m := morphemes[0]; m.Tag {
+	case VoiceCaus:
+		if firstVowel(azcase.ToLower(m.Surface)) == 0 {
+			last := []rune(s)[len([]rune(s))-1]
+			return isVowel(last) && w.an.isKnownStem(s)
+		}
+	case DerivVerbRefl, DerivRel:
+		return w.an.LookupPOS(s) == POSNoun
+	case DerivVerbAgent:
+		// A vowel-final verb takes the buffer -y- (dinləy+ici).
+		if r := []rune(s); len(r) > 1 && r[len(r)-1] == 'y' && isVowel(r[len(r)-2]) {
+			s = string(r[:len(r)-1])
+		}
+		return w.an.LookupPOS(s) == POSVerb
+	case DerivFellow, DerivPlace, DerivDim, DerivEquative:
+		return w.an.isKnownStem(s) && w.an.LookupPOS(s) != POSVerb
+	}
+	return true
+}
+
 // firstVowel returns the first vowel rune in s, or 0 if none found.
 func firstVowel(s string) rune {
 	for _, r := range s {
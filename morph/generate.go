@@ -0,0 +1,284 @@
+// Surface-form generation for Azerbaijani morphological analysis.
+//
+// Generate runs the suffix table in the forward direction: each tag is
+// resolved to a rule whose fromStates admit the current FSM state, and
+// one of the rule's surfaces is chosen using the same harmony helpers
+// that the walker uses for validation. Buffer consonants (-y-, -n-, -s-)
+// and k/q softening are applied at each morpheme boundary, and the stem
+// alternations of get, et (gedir, edəcək) and su (suyu) at the first.
+// Loanwords of the harmony exception list keep a final k or q (iştirakı).
+package morph
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// voicedStems lists the verbs whose final t voices to d before a
+// vowel-initial suffix (get → gedir, et → edəcək). Other t-final verbs
+// keep it (at → atır).
+var voicedStems = map[string]bool{"get": true, "et": true}
+
+// yBufferStems lists the vowel-final stems that take buffer -y- before
+// every vowel-initial suffix, where other vowel-final stems take -s- or
+// -n- or drop the vowel (su → suyu, suyun, suyum, not susu, sunun, sum).
+var yBufferStems = map[string]bool{"su": true}
+
+// Generate builds the inflected surface form of stem followed by the
+// suffixes named by tags, in order. It is the inverse of Analyze:
+//
+//	Generate("kitab", []MorphTag{Plural, Poss1Pl, CaseAbl}) // "kitablarımızdan"
+//
+// The suffix allomorph is chosen by vowel harmony with the preceding
+// vowel, the vowel/consonant ending of the form built so far, and the
+// preceding tag (pronominal -n- after 3rd person possessives, short
+// person endings after -dı and -sa). Standard orthography is produced,
+// so d-initial suffixes never devoice to t.
+//
+// Pronouns with irregular paradigms (mən+CaseGen → mənim, o+CaseDat →
+// ona) are taken from the pronoun table when it has the tag sequence.
+//
+// Returns an error if stem is empty or exceeds maxWordBytes, or if a tag
+// cannot follow the previous one in the morphotactic chain.
+// Casing of stem is preserved; suffixes are lowercase.
+func Generate(stem string, tags []MorphTag) (string, error) {
+	if stem == "" {
+		return "", fmt.Errorf("morph: empty stem")
+	}
+	if len(stem) > maxWordBytes {
+		return "", fmt.Errorf("morph: stem exceeds %d bytes", maxWordBytes)
+	}
+	if len(tags) > maxDepth {
+		return "", fmt.Errorf("morph: more than %d tags", maxDepth)
+	}
+	stem = azcase.ComposeNFC(stem)
+	if form, ok := generatePronoun(stem, tags); ok {
+		return form, nil
+	}
+
+	form := []rune(stem)
+	state := initial
+	var prev MorphTag
+	negAorist := false
+	for _, tag := range tags {
+		// Negation -ma/-mə loses its vowel before the present tense
+		// (gəl+mə+ir → gəlmir) and takes -z in the negative aorist
+		// (gəl+mə+ər → gəlməz), which becomes -r in the 1st person
+		// (gəlmərəm, gəlmərik).
+		if prev == Negation {
+			switch tag {
+			case TensePresent:
+				form = form[:len(form)-1]
+			case TenseAorist:
+				form = append(form, 'z')
+				state, prev, negAorist = verbAfterTense, tag, true
+				continue
+			}
+		}
+		if negAorist && (tag == Pers1Sg || tag == Pers1Pl) {
+			form[len(form)-1] = 'r'
+		}
+
+		// Plural followed by the 3rd person plural possessive collapses
+		// to a single -ları (kitab+lar+ları → kitabları).
+		lookup := tag
+		if tag == Poss3Pl && prev == Plural {
+			lookup = Poss3Sg
+		}
+
+		surface, next, ok := selectSuffix(form, state, prev, lookup)
+		if !ok {
+			if prev == 0 {
+				return "", fmt.Errorf("morph: %v cannot attach to a bare stem", tag)
+			}
+			return "", fmt.Errorf("morph: %v cannot follow %v", tag, prev)
+		}
+		if prev == 0 && isVowel(firstRune(surface)) && voicedStems[azcase.ToLower(string(form))] {
+			form[len(form)-1] = voiced(form[len(form)-1])
+		}
+		if prev == 0 && isHarmonyException(azcase.ToLower(string(form))) {
+			form = append(form, []rune(surface)...) // iştirak+ı → iştirakı
+		} else {
+			form = attachSuffix(form, surface)
+		}
+		state, prev = next, tag
+	}
+	return string(form), nil
+}
+
+// voiced returns the voiced counterpart d of t, keeping case.
+func voiced(r rune) rune {
+	if r == 'T' {
+		return 'D'
+	}
+	return 'd'
+}
+
+// selectSuffix returns the surface of tag that fits after form, together
+// with the FSM state reached. Rules are tried in table order, so the first
+// rule for a tag is its default realisation.
+func selectSuffix(form []rune, state fsmState, prev, tag MorphTag) (string, fsmState, bool) {
+	for i := range suffixRules {
+		rule := &suffixRules[i]
+		if rule.tag != tag || !slices.Contains(rule.fromStates, state) {
+			continue
+		}
+		if s := pickAllomorph(rule, form, prev); s != "" {
+			return s, rule.toState, true
+		}
+	}
+	return "", 0, false
+}
+
+// pickAllomorph chooses one surface from rule for attachment after form.
+// A vowel-initial surface after a vowel-final form takes buffer -y-
+// (oxu+ur → oxuyur) when no consonant-initial allomorph fits. A stem of
+// yBufferStems takes the post-consonant allomorph behind -y- (su+u →
+// suyu). Returns "" if no surface fits.
+func pickAllomorph(rule *suffixRule, form []rune, prev MorphTag) string {
+	low := azcase.ToLower(string(form))
+	lv := harmonyVowel(low)
+	yStem := prev == 0 && yBufferStems[low]
+	endsV := len(form) > 0 && isVowel(form[len(form)-1]) && !yStem
+
+	for si, s := range rule.surfaces {
+		sr := rule.surfaceRunes[si]
+		if sr[0] == 't' && hasDTVariants(rule) {
+			continue
+		}
+		if !harmonizes(rule.harmony, lv, sr) || !fitsContext(rule.tag, sr, endsV, prev) {
+			continue
+		}
+		if rule.tag == CaseIns && endsV || yStem && (isVowel(sr[0]) || rule.tag == CaseIns) {
+			return "y" + s // ata+la → atayla, su+u → suyu
+		}
+		return s
+	}
+
+	if !endsV {
+		return ""
+	}
+	for si, s := range rule.surfaces {
+		sr := rule.surfaceRunes[si]
+		if isVowel(sr[0]) && harmonizes(rule.harmony, lv, sr) {
+			return "y" + s
+		}
+	}
+	return ""
+}
+
+// harmonizes reports whether surface agrees with the form's last vowel lv
+// under the given harmony kind. Vowelless person endings -q/-k still
+// alternate by backness (gəldik vs aldıq).
+func harmonizes(kind harmonyKind, lv rune, surface []rune) bool {
+	fv := firstVowel(string(surface))
+	if fv == 0 {
+		last := surface[len(surface)-1]
+		if lv != 0 && (last == 'q' || last == 'k') {
+			return (last == 'q') == isBackVowel(azcase.Lower(lv))
+		}
+		return true
+	}
+	switch kind {
+	case backFront:
+		return matchesBackFront(lv, fv)
+	case fourWay:
+		return matchesFourWay(lv, fv)
+	}
+	return true
+}
+
+// fitsContext reports whether surface is the allomorph of tag used after
+// a form ending in a vowel (endsV) and following the prev tag.
+func fitsContext(tag MorphTag, surface []rune, endsV bool, prev MorphTag) bool {
+	first := surface[0]
+	switch {
+	case isVowel(first):
+		return !endsV
+	case isPersonTag(tag) && tag != Pers3:
+		// Short endings (-m, -n, -q/-k, -nız) follow -dı and -sa;
+		// every other tense takes the long endings (-am, -san, -ıq, -sınız).
+		short := firstVowel(string(surface)) == 0 || (tag == Pers2Pl && first == 'n')
+		return short == (prev == TensePastDef || prev == MoodCond)
+	case first == 'n' && isCaseTag(tag):
+		// Pronominal -n- after 3rd person possessives (kitabına),
+		// plain -n- buffer for genitive and accusative after vowels (atanın).
+		return isPoss3(prev) || (endsV && (tag == CaseGen || tag == CaseAcc))
+	case first == 'y':
+		return endsV && !isPoss3(prev)
+	case isPossTag(tag) && tag != Poss3Pl:
+		// -m, -n, -mız, -nız, -sı are the post-vowel possessive forms.
+		return endsV
+	case firstVowel(string(surface)) == 0:
+		// Vowelless suffixes such as causative -t attach only after vowels.
+		return endsV
+	}
+	return true
+}
+
+// attachSuffix appends surface to form, softening a final k/q of a
+// polysyllabic form to y/ğ before a vowel-initial suffix (ürək → ürəyi).
+// Monosyllables keep the stop (ok → oku, çək → çəkir).
+func attachSuffix(form []rune, surface string) []rune {
+	n := len(form)
+	if n > 0 && isVowel(firstRune(surface)) && countVowels(form) >= 2 {
+		switch form[n-1] {
+		case 'k':
+			form[n-1] = 'y'
+		case 'K':
+			form[n-1] = 'Y'
+		case 'q':
+			form[n-1] = '\u011F' // ğ
+		case 'Q':
+			form[n-1] = '\u011E' // Ğ
+		}
+	}
+	return append(form, []rune(surface)...)
+}
+
+// firstRune returns the first rune of s, or 0 if s is empty.
+func firstRune(s string) rune {
+	for _, r := range s {
+		return r
+	}
+	return 0
+}
+
+// countVowels returns the number of vowels in rs.
+func countVowels(rs []rune) int {
+	n := 0
+	for _, r := range rs {
+		if isVowel(r) {
+			n++
+		}
+	}
+	return n
+}
+
+// isPossTag reports whether t is a possessive suffix tag.
+func isPossTag(t MorphTag) bool {
+	return t >= Poss1Sg && t <= Poss3Pl
+}
+
+// isPoss3 reports whether t is a 3rd person possessive, after which
+// case suffixes take pronominal -n-.
+func isPoss3(t MorphTag) bool {
+	return t == Poss3Sg || t == Poss3Pl
+}
+
+// isCaseTag reports whether t is a case suffix tag.
+func isCaseTag(t MorphTag) bool {
+	return t >= CaseGen && t <= CaseIns
+}
+
+// isPersonTag reports whether t is a verbal person suffix tag.
+func isPersonTag(t MorphTag) bool {
+	return t >= Pers1Sg && t <= Pers3
+}
+
+// isConverbTag reports whether t is a converb suffix tag.
+func isConverbTag(t MorphTag) bool {
+	return t >= ConverbSeq && t <= ConverbWhen
+}
@@ -0,0 +1,251 @@
+package morph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		name string
+		stem string
+		tags []MorphTag
+		want string
+	}{
+		// -- Bare stem --
+		{"no tags", "kitab", nil, "kitab"},
+
+		// -- Noun chains --
+		{"plural back", "kitab", []MorphTag{Plural}, "kitablar"},
+		{"plural front", "ev", []MorphTag{Plural}, "evlər"},
+		{"plural poss1pl abl", "kitab", []MorphTag{Plural, Poss1Pl, CaseAbl}, "kitablarımızdan"},
+		{"plural loc", "ev", []MorphTag{Plural, CaseLoc}, "evlərdə"},
+		{"plural poss2pl ins", "göz", []MorphTag{Plural, Poss2Pl, CaseIns}, "gözlərinizlə"},
+
+		// -- Four-way harmony --
+		{"poss1sg ı", "kitab", []MorphTag{Poss1Sg}, "kitabım"},
+		{"poss1sg i", "ev", []MorphTag{Poss1Sg}, "evim"},
+		{"poss1sg u", "yol", []MorphTag{Poss1Sg}, "yolum"},
+		{"poss1sg ü", "göz", []MorphTag{Poss1Sg}, "gözüm"},
+
+		// -- Buffer consonants after vowel-final stems --
+		{"poss1sg after vowel", "ata", []MorphTag{Poss1Sg}, "atam"},
+		{"poss3sg buffer s", "ata", []MorphTag{Poss3Sg}, "atası"},
+		{"gen buffer n", "ata", []MorphTag{CaseGen}, "atanın"},
+		{"acc buffer n", "ata", []MorphTag{CaseAcc}, "atanı"},
+		{"dat buffer y", "ata", []MorphTag{CaseDat}, "ataya"},
+		{"loc after vowel", "ata", []MorphTag{CaseLoc}, "atada"},
+		{"ins buffer y", "ata", []MorphTag{CaseIns}, "atayla"},
+		{"poss3sg su", "su", []MorphTag{Poss3Sg}, "suyu"},
+		{"gen su", "su", []MorphTag{CaseGen}, "suyun"},
+		{"poss1sg su", "su", []MorphTag{Poss1Sg}, "suyum"},
+		{"ins su", "su", []MorphTag{CaseIns}, "suyla"},
+		{"plural su", "su", []MorphTag{Plural, Poss3Sg}, "suları"},
+
+		// -- Pronominal n after 3rd person possessive --
+		{"poss3sg dat", "kitab", []MorphTag{Poss3Sg, CaseDat}, "kitabına"},
+		{"poss3sg loc", "kitab", []MorphTag{Poss3Sg, CaseLoc}, "kitabında"},
+		{"poss3sg abl", "ev", []MorphTag{Poss3Sg, CaseAbl}, "evindən"},
+		{"poss3pl gen", "ev", []MorphTag{Poss3Pl, CaseGen}, "evlərinin"},
+		{"plural poss3pl haplology", "kitab", []MorphTag{Plural, Poss3Pl}, "kitabları"},
+		{"plural poss3pl dat", "ev", []MorphTag{Plural, Poss3Pl, CaseDat}, "evlərinə"},
+
+		// -- k/q softening --
+		{"softening k", "ürək", []MorphTag{CaseAcc}, "ürəyi"},
+		{"softening q", "uşaq", []MorphTag{Poss3Sg}, "uşağı"},
+		{"softening dat", "ürək", []MorphTag{CaseDat}, "ürəyə"},
+		{"no softening monosyllable", "ok", []MorphTag{CaseAcc}, "oku"},
+		{"no softening before consonant", "uşaq", []MorphTag{Plural}, "uşaqlar"},
+		{"softening inside chain", "kitab", []MorphTag{DerivAgent, DerivAbstract, Poss3Sg}, "kitabçılığı"},
+
+		// -- Derivations --
+		{"fellow", "yol", []MorphTag{DerivFellow, DerivAbstract}, "yoldaşlıq"},
+		{"diminutive", "ev", []MorphTag{DerivDim}, "evcik"},
+		{"verb agent", "dinlə", []MorphTag{DerivVerbAgent}, "dinləyici"},
+		{"stacked verb derivation", "yaxşı", []MorphTag{DerivVerb, VoiceCaus, VoicePass, TensePastIndef}, "yaxşılaşdırılmış"},
+
+		// -- Consonant assimilation: standard orthography keeps d --
+		{"loc after voiceless", "çiçək", []MorphTag{CaseLoc}, "çiçəkdə"},
+		{"past after voiceless", "get", []MorphTag{TensePastDef}, "getdi"},
+
+		// -- t/d alternation of get and et --
+		{"get present", "get", []MorphTag{TensePresent}, "gedir"},
+		{"et future 1sg", "et", []MorphTag{TenseFuture, Pers1Sg}, "edəcəyəm"},
+		{"get converb", "get", []MorphTag{ConverbSeq}, "gedib"},
+		{"et negation", "et", []MorphTag{Negation, TensePastDef}, "etmədi"},
+		{"title case get", "Get", []MorphTag{TensePresent}, "Gedir"},
+		{"no voicing at", "at", []MorphTag{TensePresent}, "atır"},
+
+		// -- Verb conjugation --
+		{"present 1sg", "gəl", []MorphTag{TensePresent, Pers1Sg}, "gəlirəm"},
+		{"present 2sg", "bil", []MorphTag{TensePresent, Pers2Sg}, "bilirsən"},
+		{"present 1pl", "al", []MorphTag{TensePresent, Pers1Pl}, "alırıq"},
+		{"present 3pl", "bil", []MorphTag{TensePresent, Pers3}, "bilirlər"},
+		{"present after vowel", "oxu", []MorphTag{TensePresent, Pers2Pl}, "oxuyursunuz"},
+		{"past 1sg", "gəl", []MorphTag{TensePastDef, Pers1Sg}, "gəldim"},
+		{"past 1pl back", "yaz", []MorphTag{TensePastDef, Pers1Pl}, "yazdıq"},
+		{"past 1pl front", "gəl", []MorphTag{TensePastDef, Pers1Pl}, "gəldik"},
+		{"past 2pl", "gəl", []MorphTag{TensePastDef, Pers2Pl}, "gəldiniz"},
+		{"future 1sg softening", "gəl", []MorphTag{TenseFuture, Pers1Sg}, "gələcəyəm"},
+		{"future 2sg", "yaz", []MorphTag{TenseFuture, Pers2Sg}, "yazacaqsan"},
+		{"indef copula", "gəl", []MorphTag{TensePastIndef, Copula}, "gəlmişdir"},
+		{"oblig 1sg buffer", "gəl", []MorphTag{MoodOblig, Pers1Sg}, "gəlməliyəm"},
+		{"cond 2pl short", "gəl", []MorphTag{MoodCond, Pers2Pl}, "gəlsəniz"},
+		{"evidential 2sg", "gəl", []MorphTag{TensePastEvi, Pers2Sg}, "gəlibsən"},
+		{"question", "yaz", []MorphTag{TensePastDef, Pers3, Question}, "yazdılarmı"},
+
+		// -- Negation --
+		{"neg past", "gəl", []MorphTag{Negation, TensePastDef}, "gəlmədi"},
+		{"neg present", "gəl", []MorphTag{Negation, TensePresent, Pers1Sg}, "gəlmirəm"},
+		{"neg present back", "oxu", []MorphTag{Negation, TensePresent}, "oxumur"},
+		{"neg future", "gəl", []MorphTag{Negation, TenseFuture}, "gəlməyəcək"},
+		{"neg aorist", "gəl", []MorphTag{Negation, TenseAorist}, "gəlməz"},
+		{"neg aorist 1sg", "gəl", []MorphTag{Negation, TenseAorist, Pers1Sg}, "gəlmərəm"},
+		{"neg aorist 1pl", "yaz", []MorphTag{Negation, TenseAorist, Pers1Pl}, "yazmarıq"},
+		{"neg aorist 2sg", "gəl", []MorphTag{Negation, TenseAorist, Pers2Sg}, "gəlməzsən"},
+		{"neg participle", "bil", []MorphTag{Negation, Participle}, "bilməyən"},
+
+		// -- Converbs and relative participles --
+		{"converb seq after vowel", "oxu", []MorphTag{ConverbSeq}, "oxuyub"},
+		{"converb while", "gəl", []MorphTag{ConverbWhile}, "gələndə"},
+		{"converb without", "yaz", []MorphTag{ConverbWithout}, "yazmadan"},
+		{"converb when", "gəl", []MorphTag{ConverbWhen}, "gəldikdə"},
+		{"rel participle softening", "yaz", []MorphTag{ParticiplePast, Poss1Sg}, "yazdığım"},
+		{"rel participle poss3pl", "yaz", []MorphTag{ParticiplePast, Poss3Pl}, "yazdıqları"},
+		{"rel future", "gəl", []MorphTag{ParticipleFuture, Poss1Sg}, "gələcəyim"},
+
+		// -- Voice --
+		{"passive", "yaz", []MorphTag{VoicePass, TensePastEvi}, "yazılıb"},
+		{"causative t after vowel", "oxu", []MorphTag{VoiceCaus, TensePastDef}, "oxutdu"},
+
+		// -- Case preservation --
+		{"title case", "Kitab", []MorphTag{Plural}, "Kitablar"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Generate(tt.stem, tt.tags)
+			if err != nil {
+				t.Fatalf("Generate(%q, %v) error: %v", tt.stem, tt.tags, err)
+			}
+			if got != tt.want {
+				t.Errorf("Generate(%q, %v) = %q, want %q", tt.stem, tt.tags, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		stem string
+		tags []MorphTag
+	}{
+		{"empty stem", "", []MorphTag{Plural}},
+		{"too long", strings.Repeat("a", maxWordBytes+1), nil},
+		{"too many tags", "ev", make([]MorphTag, maxDepth+1)},
+		{"case before plural", "kitab", []MorphTag{CaseAbl, Plural}},
+		{"person on bare stem", "gəl", []MorphTag{Pers1Sg}},
+		{"tense after case", "ev", []MorphTag{CaseLoc, TensePastDef}},
+		{"tag without rule", "gəl", []MorphTag{MoodImper}},
+		{"unknown tag", "gəl", []MorphTag{MorphTag(999)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := Generate(tt.stem, tt.tags); err == nil {
+				t.Errorf("Generate(%q, %v) = %q, want error", tt.stem, tt.tags, got)
+			}
+		})
+	}
+}
+
+// TestGenerateRoundTrip checks that every generated form is decomposed by
+// Analyze back into the same stem and the exact same tag sequence. The
+// stem may carry the alternations verbStemCandidates repairs (ged+ir,
+// suy+u).
+func TestGenerateRoundTrip(t *testing.T) {
+	tests := []struct {
+		stem string
+		tags []MorphTag
+	}{
+		{"kitab", []MorphTag{Plural}},
+		{"kitab", []MorphTag{Plural, Poss1Pl, CaseAbl}},
+		{"kitab", []MorphTag{Poss1Sg}},
+		{"kitab", []MorphTag{Poss3Sg, CaseLoc}},
+		{"ev", []MorphTag{Plural, CaseLoc}},
+		{"ev", []MorphTag{CaseAbl}},
+		{"ev", []MorphTag{CaseDat}},
+		{"ürək", []MorphTag{CaseAcc}},
+		{"uşaq", []MorphTag{Poss3Sg}},
+		{"kitab", []MorphTag{DerivAgent, DerivAbstract}},
+		{"iş", []MorphTag{DerivPriv}},
+		{"gəl", []MorphTag{TensePastIndef, Copula}},
+		{"gəl", []MorphTag{TensePastDef, Pers1Sg}},
+		{"yaz", []MorphTag{TensePastDef, Pers3}},
+		{"yaz", []MorphTag{TensePastDef, Pers1Pl}},
+		{"bil", []MorphTag{TensePresent, Pers1Sg}},
+		{"bil", []MorphTag{TensePresent, Pers2Sg}},
+		{"bil", []MorphTag{TensePresent, Pers1Pl}},
+		{"bil", []MorphTag{TensePresent, Pers2Pl}},
+		{"bil", []MorphTag{TensePresent, Pers3}},
+		{"yaz", []MorphTag{TenseAorist, Pers1Sg}},
+		{"gəl", []MorphTag{TensePastEvi, Pers2Sg}},
+		{"gəl", []MorphTag{Negation, TensePastDef}},
+		{"yaz", []MorphTag{VoicePass, TensePastEvi}},
+		{"get", []MorphTag{TensePresent}},
+		{"get", []MorphTag{TensePresent, Pers1Sg}},
+		{"et", []MorphTag{TenseFuture, Pers1Sg}},
+		{"et", []MorphTag{ConverbSeq}},
+		{"su", []MorphTag{Poss3Sg}},
+		{"su", []MorphTag{CaseGen}},
+		{"su", []MorphTag{Poss1Sg, CaseLoc}},
+	}
+
+	for _, tt := range tests {
+		word, err := Generate(tt.stem, tt.tags)
+		if err != nil {
+			t.Fatalf("Generate(%q, %v) error: %v", tt.stem, tt.tags, err)
+		}
+		t.Run(word, func(t *testing.T) {
+			results := Analyze(word)
+			for _, a := range results {
+				if tagsKey(a.Morphemes) == tagsKey(toMorphemes(tt.tags)) && slices.Contains(verbStemCandidates(a), tt.stem) {
+					return
+				}
+			}
+			t.Errorf("Analyze(%q) missing %s%v\n  got: %v", word, tt.stem, tt.tags, results)
+		})
+	}
+}
+
+// toMorphemes wraps tags in morphemes with empty surfaces for tagsKey.
+func toMorphemes(tags []MorphTag) []Morpheme {
+	out := make([]Morpheme, len(tags))
+	for i, tag := range tags {
+		out[i] = Morpheme{Tag: tag}
+	}
+	return out
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	tags := []MorphTag{Plural, Poss1Pl, CaseAbl}
+	for b.Loop() {
+		_, _ = Generate("kitab", tags)
+	}
+}
+
+func ExampleGenerate() {
+	word, _ := Generate("kitab", []MorphTag{Plural, Poss1Pl, CaseAbl})
+	fmt.Println(word)
+	word, _ = Generate("ürək", []MorphTag{Poss3Sg, CaseDat})
+	fmt.Println(word)
+	word, _ = Generate("gəl", []MorphTag{TenseFuture, Pers1Sg})
+	fmt.Println(word)
+	// Output:
+	// kitablarımızdan
+	// ürəyinə
+	// gələcəyəm
+}
@@ -0,0 +1,155 @@
+// Leipzig-style interlinear glossing for Azerbaijani morphological analysis.
+//
+// Analysis.Gloss renders an analysis as a segmented line and a gloss line
+// with one hyphen-separated segment per morpheme, following the Leipzig
+// Glossing Rules: kitab-lar-ımız-dan / kitab-PL-POSS.1PL-ABL. The package
+// has no translation lexicon, so a stem is glossed by itself unless an
+// Analyzer was given one in Options.Glosses (kitab-PL-POSS.1PL-ABL becomes
+// book-PL-POSS.1PL-ABL); personal pronouns are glossed by person and
+// number (mən-im / 1SG-GEN). The question particle is a clitic and is
+// joined with =, and the parts of a reduplication with ~ (kitab~mitab /
+// kitab~RED).
+package morph
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// pronounGlosses maps pronoun lemmas to their Leipzig glosses.
+var pronounGlosses = map[string]string{
+	"mən": "1SG",
+	"sən": "2SG",
+	"o":   "3SG",
+	"biz": "1PL",
+	"siz": "2PL",
+	"bu":  "PROX",
+}
+
+// Gloss is one word of interlinear glossed text.
+type Gloss struct {
+	Segmented string `json:"segmented"` // Word split into morphemes (kitab-lar-ımız-dan)
+	Glossed   string `json:"glossed"`   // One label per morpheme (kitab-PL-POSS.1PL-ABL)
+}
+
+// String returns the two lines of g joined by " / ".
+func (g Gloss) String() string {
+	return g.Segmented + " / " + g.Glossed
+}
+
+// Gloss returns the Leipzig-style interlinear gloss of the analysis.
+// Suffixes are glossed with MorphTag.Gloss; a 3rd person -lar is 3PL.
+func (a Analysis) Gloss() Gloss {
+	return a.gloss(nil)
+}
+
+// Gloss is like Analysis.Gloss but glosses stems with the Analyzer's
+// stem glosses where it has one.
+func (an *Analyzer) Gloss(a Analysis) Gloss {
+	return a.gloss(an.glosses)
+}
+
+// gloss returns the gloss of a, looking stems up in glosses.
+func (a Analysis) gloss(glosses map[string]string) Gloss {
+	var seg, gl strings.Builder
+	a.glossStem(&seg, &gl, glosses)
+	for _, m := range a.Morphemes {
+		sep := "-"
+		if m.Tag == Question {
+			sep = "="
+		}
+		label := m.Tag.Gloss()
+		if m.Tag == Pers3 && strings.HasPrefix(azcase.ToLower(m.Surface), "l") {
+			label = "3PL"
+		}
+		seg.WriteString(sep)
+		seg.WriteString(m.Surface)
+		gl.WriteString(sep)
+		gl.WriteString(label)
+	}
+	return Gloss{Segmented: seg.String(), Glossed: gl.String()}
+}
+
+// glossStem writes the stem of a to the segmented and gloss lines. A stem
+// in glosses is glossed by its entry, which takes precedence over the
+// pronoun glosses.
+func (a Analysis) glossStem(seg, gl *strings.Builder, glosses map[string]string) {
+	c := a.Compound
+	if c == nil || c.Reduplication == RedupNone || len(c.Parts) != 2 {
+		seg.WriteString(a.Stem)
+		gl.WriteString(stemGloss(a.Stem, glosses))
+		return
+	}
+	first, second := c.Parts[0].Stem, c.Parts[1].Stem
+	seg.WriteString(first + "~" + second)
+	if c.Reduplication == RedupEmphatic {
+		gl.WriteString("INTS~" + stemGloss(second, glosses))
+	} else {
+		gl.WriteString(stemGloss(first, glosses) + "~RED")
+	}
+}
+
+// stemGloss returns the gloss of stem: its entry in glosses, its pronoun
+// gloss, or the stem itself.
+func stemGloss(stem string, glosses map[string]string) string {
+	low := azcase.ToLower(stem)
+	if g, ok := glosses[low]; ok {
+		return g
+	}
+	if g, ok := pronounGlosses[low]; ok {
+		return g
+	}
+	return stem
+}
+
+// GlossSentence returns the interlinear gloss of each word, using the
+// analysis AnalyzeSentence selects. Designed to be used with
+// tokenizer.Words(). Returns nil if words is nil.
+func GlossSentence(words []string) []Gloss {
+	return defaultAnalyzer.GlossSentence(words)
+}
+
+// GlossSentence is like the package-level GlossSentence but analyzes with
+// the Analyzer's lexicon and glosses stems with its stem glosses.
+func (an *Analyzer) GlossSentence(words []string) []Gloss {
+	if words == nil {
+		return nil
+	}
+	analyses := an.AnalyzeSentence(words)
+	out := make([]Gloss, len(analyses))
+	for i, a := range analyses {
+		out[i] = an.Gloss(a)
+	}
+	return out
+}
+
+// Interlinear lays out glosses as two lines, the segmented words above
+// their glosses, each word padded so that the columns align. For mənim
+// kitablarım the result is
+//
+//	mən-im  kitab-lar-ım
+//	1SG-GEN kitab-PL-POSS.1SG
+//
+// Returns "" for no glosses.
+func Interlinear(glosses []Gloss) string {
+	if len(glosses) == 0 {
+		return ""
+	}
+	var top, bottom strings.Builder
+	for i, g := range glosses {
+		if i > 0 {
+			top.WriteByte(' ')
+			bottom.WriteByte(' ')
+		}
+		w := max(utf8.RuneCountInString(g.Segmented), utf8.RuneCountInString(g.Glossed))
+		top.WriteString(g.Segmented)
+		bottom.WriteString(g.Glossed)
+		if i < len(glosses)-1 {
+			top.WriteString(strings.Repeat(" ", w-utf8.RuneCountInString(g.Segmented)))
+			bottom.WriteString(strings.Repeat(" ", w-utf8.RuneCountInString(g.Glossed)))
+		}
+	}
+	return top.String() + "\n" + bottom.String()
+}
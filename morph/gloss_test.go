@@ -0,0 +1,155 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestAnalysisGloss(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"kitablarımızdan", "kitab-lar-ımız-dan / kitab-PL-POSS.1PL-ABL"},
+		{"evdə", "ev-də / ev-LOC"},
+		{"kitab", "kitab / kitab"},
+		{"gəlmədilər", "gəl-mə-di-lər / gəl-NEG-PST-3PL"},
+		{"yazılmışdır", "yazıl-mış-dır / yazıl-EVID-COP"},
+
+		// -- Pronouns are glossed by person and number --
+		{"mənim", "mən-im / 1SG-GEN"},
+		{"Onlara", "O-nlar-a / 3SG-PL-DAT"},
+
+		// -- Clitics and reduplication --
+		{"evdəmi", "evdə=mi / evdə=Q"},
+		{"kitab-mitablar", "kitab~mitab-lar / kitab~RED-PL"},
+		{"qap-qara", "qap~qara / INTS~qara"},
+		{"elmi-tədqiqat", "elmi-tədqiqat / elmi-tədqiqat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Analyze(tt.word)[0].Gloss().String(); got != tt.want {
+				t.Errorf("Analyze(%q)[0].Gloss() = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMorphTagGlossCompleteness(t *testing.T) {
+	for tag, name := range morphTagNames {
+		if _, ok := morphTagGlosses[tag]; !ok {
+			t.Errorf("%s has no gloss", name)
+		}
+	}
+	if got := MorphTag(999).Gloss(); got != "MorphTag(999)" {
+		t.Errorf("MorphTag(999).Gloss() = %q, want MorphTag(999)", got)
+	}
+}
+
+func TestGlossSentence(t *testing.T) {
+	got := GlossSentence([]string{"Mən", "kitablarımı", "oxudum"})
+	want := []string{"Mən / 1SG", "kitab-lar-ım-ı / kitab-PL-POSS.1SG-ACC", "oxu-du-m / oxu-PST-1SG"}
+	if len(got) != len(want) {
+		t.Fatalf("GlossSentence returned %d glosses, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("GlossSentence[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if GlossSentence(nil) != nil {
+		t.Error("GlossSentence(nil) != nil")
+	}
+}
+
+func TestAnalyzerGloss(t *testing.T) {
+	an := NewAnalyzer(Options{Glosses: map[string]string{
+		"Kitab": "book", "oxu": "read", "qara": "black", "mən": "I", "ev": " ",
+	}})
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"kitablarımızdan", "kitab-lar-ımız-dan / book-PL-POSS.1PL-ABL"},
+		{"Kitab", "Kitab / book"},
+		{"oxudum", "oxu-du-m / read-PST-1SG"},
+		{"kitab-mitablar", "kitab~mitab-lar / book~RED-PL"},
+		{"qap-qara", "qap~qara / INTS~black"},
+		// Lexicon entries take precedence over the pronoun glosses.
+		{"mənim", "mən-im / I-GEN"},
+		// Blank glosses are ignored.
+		{"evdə", "ev-də / ev-LOC"},
+		// Stems outside the lexicon are glossed by themselves.
+		{"gəlmədilər", "gəl-mə-di-lər / gəl-NEG-PST-3PL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			a := an.Analyze(tt.word)[0]
+			if got := an.Gloss(a).String(); got != tt.want {
+				t.Errorf("Analyzer.Gloss(%v) = %q, want %q", a, got, tt.want)
+			}
+		})
+	}
+
+	got := an.GlossSentence([]string{"Mən", "kitablarımı", "oxudum"})
+	want := []string{"Mən / I", "kitab-lar-ım-ı / book-PL-POSS.1SG-ACC", "oxu-du-m / read-PST-1SG"}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("Analyzer.GlossSentence[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if got := Analyze("kitablar")[0].Gloss().String(); got != "kitab-lar / kitab-PL" {
+		t.Errorf("Analyze(kitablar)[0].Gloss() = %q, want the stem unglossed", got)
+	}
+}
+
+func TestInterlinear(t *testing.T) {
+	gs := []Gloss{
+		{Segmented: "mən-im", Glossed: "1SG-GEN"},
+		{Segmented: "kitab-lar-ım", Glossed: "kitab-PL-POSS.1SG"},
+	}
+	want := "mən-im  kitab-lar-ım\n1SG-GEN kitab-PL-POSS.1SG"
+	if got := Interlinear(gs); got != want {
+		t.Errorf("Interlinear = %q, want %q", got, want)
+	}
+	if got := Interlinear(nil); got != "" {
+		t.Errorf("Interlinear(nil) = %q, want empty", got)
+	}
+}
+
+func TestGlossJSON(t *testing.T) {
+	data, err := json.Marshal(Gloss{Segmented: "ev-də", Glossed: "ev-LOC"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"segmented":"ev-də","glossed":"ev-LOC"}`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+}
+
+func BenchmarkGloss(b *testing.B) {
+	a := Analyze("kitablarımızdan")[0]
+	for b.Loop() {
+		a.Gloss()
+	}
+}
+
+func ExampleAnalysis_Gloss() {
+	fmt.Println(Analyze("kitablarımızdan")[0].Gloss())
+	// Output: kitab-lar-ımız-dan / kitab-PL-POSS.1PL-ABL
+}
+
+func ExampleAnalyzer_Gloss() {
+	an := NewAnalyzer(Options{Glosses: map[string]string{"kitab": "book"}})
+	fmt.Println(an.Gloss(an.Analyze("kitablarımızdan")[0]))
+	// Output: kitab-lar-ımız-dan / book-PL-POSS.1PL-ABL
+}
+
+func ExampleInterlinear() {
+	fmt.Println(Interlinear(GlossSentence([]string{"Mən", "kitablarımı", "oxudum"})))
+	// Output:
+	// Mən kitab-lar-ım-ı        oxu-du-m
+	// 1SG kitab-PL-POSS.1SG-ACC oxu-PST-1SG
+}
@@ -0,0 +1,79 @@
+// Loanword harmony exceptions for Azerbaijani morphological analysis.
+//
+// Suffix vowels normally agree with the last written vowel of the stem,
+// but loanwords are often mis-stemmed or mis-inflected: the l of gol or
+// final is taken for -lu/-lı, hallar is read as hall+ar, and iştirak is
+// softened to iştirayı. A few break harmony outright because their
+// spelling hides how they are pronounced (sinql+ı, dubl+i).
+// data/harmony.txt lists such stems with the four-way harmony class their
+// suffixes follow. The walker checks the first suffix after a listed stem
+// against that class, and Stem prefers a listed stem over other known
+// stems of the word; Generate picks allomorphs by the class and keeps a
+// final k or q. The list does not make its stems known: dictionary
+// membership comes from dict.txt alone.
+package morph
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// harmonyExceptions maps lowercase loanword stems to the vowel their
+// suffixes harmonize with, populated by init().
+var harmonyExceptions map[string]rune
+
+func init() {
+	harmonyExceptions = parseHarmonyExceptions(data.HarmonyExceptions)
+}
+
+// parseHarmonyExceptions parses tab-separated "stem\tvowel" lines.
+// Lines with an invalid stem or a vowel other than ı, u, i, ü are skipped.
+func parseHarmonyExceptions(raw []byte) map[string]rune {
+	m := make(map[string]rune)
+	for _, line := range bytes.Split(raw, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		stem, class, ok := bytes.Cut(line, []byte("\t"))
+		if !ok {
+			continue
+		}
+		s := azcase.ToLower(azcase.ComposeNFC(string(bytes.TrimSpace(stem))))
+		v, size := utf8.DecodeRune(bytes.TrimSpace(class))
+		if !isValidStem(s) || size != len(bytes.TrimSpace(class)) || !isHarmonyClass(v) {
+			continue
+		}
+		m[s] = v
+	}
+	return m
+}
+
+// isHarmonyClass reports whether v names a four-way harmony class.
+func isHarmonyClass(v rune) bool {
+	switch v {
+	case 'ı', 'u', 'i', 'ü':
+		return true
+	}
+	return false
+}
+
+// harmonyVowel returns the vowel that suffixes attached to s agree with:
+// the class of a listed loanword stem, or else the last vowel of s.
+// Expects lowercase input.
+func harmonyVowel(s string) rune {
+	if v, ok := harmonyExceptions[s]; ok {
+		return v
+	}
+	return lastVowel(s)
+}
+
+// isHarmonyException reports whether s is a listed loanword stem.
+// Expects lowercase input.
+func isHarmonyException(s string) bool {
+	_, ok := harmonyExceptions[s]
+	return ok
+}
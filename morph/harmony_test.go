@@ -0,0 +1,155 @@
+package morph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHarmonyExceptionsData(t *testing.T) {
+	if len(harmonyExceptions) == 0 {
+		t.Fatal("harmony exception list is empty")
+	}
+	for s, v := range harmonyExceptions {
+		if !isValidStem(s) || !isHarmonyClass(v) {
+			t.Errorf("invalid entry %q -> %q", s, v)
+		}
+		if !isKnownStem(s) {
+			t.Errorf("%q is listed but not in the dictionary", s)
+		}
+		// Inflected forms belong to the walker, not the dictionary.
+		for _, tags := range [][]MorphTag{{CaseAcc}, {CaseDat}, {Plural}} {
+			if form, err := Generate(s, tags); err == nil && isKnownStem(form) {
+				t.Errorf("inflected form %q of listed %q is in the dictionary", form, s)
+			}
+		}
+	}
+}
+
+func TestParseHarmonyExceptions(t *testing.T) {
+	raw := []byte("# comment\n\nSaat\ti\ngol\tu\nbad\tx\nnovowel\nxy\tü\nkanal\tıı\n")
+	got := parseHarmonyExceptions(raw)
+	want := map[string]rune{"saat": 'i', "gol": 'u'}
+	if len(got) != len(want) {
+		t.Fatalf("parseHarmonyExceptions = %q, want %q", got, want)
+	}
+	for s, v := range want {
+		if got[s] != v {
+			t.Errorf("parseHarmonyExceptions[%q] = %q, want %q", s, got[s], v)
+		}
+	}
+}
+
+func TestStemLoanwords(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		// -- Arabic loans: stem + suffix, not a longer known stem --
+		{"saatı", "saat"},
+		{"saatını", "saat"},
+		{"saata", "saat"},
+		{"halı", "hal"},
+		{"halımız", "hal"},
+		{"halını", "hal"},
+		{"hallar", "hal"},
+		{"iştirakı", "iştirak"},
+
+		// -- l-final loans are not split as -lu/-lı --
+		{"golu", "gol"},
+		{"rolu", "rol"},
+		{"kontrolu", "kontrol"},
+		{"alkoqolu", "alkoqol"},
+		{"voleybolu", "voleybol"},
+		{"finalı", "final"},
+		{"idealı", "ideal"},
+		{"rollarda", "rol"},
+
+		// -- Derived words keep their own stem --
+		{"iştirakçılar", "iştirakçı"},
+		{"futbolçular", "futbolçu"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHarmonyExceptionClasses(t *testing.T) {
+	tests := []struct {
+		class    string
+		stem     string
+		tags     []MorphTag
+		word     string
+		rejected string // a form with the other harmony class, or a softened stop
+	}{
+		{"arabic", "saat", []MorphTag{CaseAcc}, "saatı", "saati"},
+		{"arabic", "hal", []MorphTag{Plural, CaseLoc}, "hallarda", "hallərdə"},
+		{"arabic", "iştirak", []MorphTag{CaseAcc}, "iştirakı", "iştirayı"},
+		{"arabic", "idrak", []MorphTag{Poss1Sg}, "idrakım", "idrayım"},
+		{"european", "gol", []MorphTag{CaseAcc}, "golu", "golü"},
+		{"european", "kontrol", []MorphTag{Plural}, "kontrollar", "kontrollər"},
+		{"european", "final", []MorphTag{CaseAcc}, "finalı", "finali"},
+		{"disharmonic", "sinql", []MorphTag{CaseAcc}, "sinqlı", "sinqli"},
+		{"disharmonic", "dubl", []MorphTag{CaseAcc}, "dubli", "dublı"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.class+"/"+tt.word, func(t *testing.T) {
+			if got, err := Generate(tt.stem, tt.tags); err != nil || got != tt.word {
+				t.Errorf("Generate(%q, %v) = %q, %v, want %q", tt.stem, tt.tags, got, err, tt.word)
+			}
+			a := Analyze(tt.word)[0]
+			if a.Stem != tt.stem || len(a.Morphemes) != len(tt.tags) || !containsTags(a.Morphemes, tt.tags) {
+				t.Errorf("Analyze(%q)[0] = %v, want %s%v", tt.word, a, tt.stem, tt.tags)
+			}
+			for _, a := range Analyze(tt.rejected) {
+				if a.Stem == tt.stem && containsTags(a.Morphemes, tt.tags) {
+					t.Errorf("Analyze(%q) = %v, want no %v on %s", tt.rejected, a, tt.tags, tt.stem)
+				}
+			}
+		})
+	}
+}
+
+func TestHarmonyExceptionsNotKnown(t *testing.T) {
+	// Listing a stem fixes its suffix vowels but does not add it to the
+	// dictionary.
+	for s := range harmonyExceptions {
+		if IsKnownStem(s) != (stemDAWG.lookup(s) != 0) {
+			t.Errorf("IsKnownStem(%q) = %v, want dictionary membership only", s, IsKnownStem(s))
+		}
+	}
+}
+
+func TestGenerateLoanwords(t *testing.T) {
+	tests := []struct {
+		stem string
+		tags []MorphTag
+		want string
+	}{
+		{"gol", []MorphTag{CaseAcc}, "golu"},
+		{"final", []MorphTag{Plural, CaseLoc}, "finallarda"},
+		{"Saat", []MorphTag{Poss1Sg}, "Saatım"},
+	}
+	for _, tt := range tests {
+		if got, err := Generate(tt.stem, tt.tags); err != nil || got != tt.want {
+			t.Errorf("Generate(%q, %v) = %q, %v, want %q", tt.stem, tt.tags, got, err, tt.want)
+		}
+	}
+}
+
+func TestLoanwordsKnown(t *testing.T) {
+	for _, s := range []string{"gol", "kontrol", "final"} {
+		if !IsKnownStem(s) || LookupPOS(s) != POSNoun {
+			t.Errorf("%q: IsKnownStem = %v, LookupPOS = %v, want known noun", s, IsKnownStem(s), LookupPOS(s))
+		}
+	}
+}
+
+func ExampleStem_loanword() {
+	fmt.Println(Stem("golu"), Stem("kontrolu"))
+	// Output: gol kontrol
+}
@@ -0,0 +1,182 @@
+// Dictionary lemmatization for Azerbaijani morphological analysis.
+//
+// Lemmatize builds on Stem: the stem it selects is paired with the
+// analysis that produced it, tagged with a part of speech from the
+// dictionary POS byte, and verbs are returned in their -maq/-mək
+// infinitive citation form.
+package morph
+
+import (
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Lemma is the dictionary citation form of a word.
+type Lemma struct {
+	Form     string   `json:"form"`     // Citation form: stem, or infinitive for verbs
+	POS      POS      `json:"pos"`      // Part of speech
+	Analysis Analysis `json:"analysis"` // Analysis the lemma was taken from
+}
+
+// Lemmatize returns the dictionary lemma of an inflected Azerbaijani word.
+//
+// The lemma stem is the one chosen by Stem. Its part of speech comes from
+// the dictionary, except that a stem followed by a verbal suffix (voice,
+// negation, tense, mood, participle, person) is always a verb. Verbs are
+// returned as infinitives (yazdım → yazmaq, gəlir → gəlmək). When the
+// verb root is hidden by buffer -y- (oxuyur → oxu) or t→d voicing
+// (gedir → get), the dictionary root is restored.
+//
+// Hyphenated and apostrophe-suffixed words are lemmatized like Stem
+// without a morpheme breakdown. Words exceeding maxWordBytes are returned
+// unchanged with POSUnknown. Returns the zero Lemma for empty input.
+// Cyrillic input gives a Cyrillic form and analysis (јаздым → јазмаг).
+func Lemmatize(word string) Lemma {
+	return defaultAnalyzer.Lemmatize(word)
+}
+
+// Lemmatize is like the package-level Lemmatize but uses the Analyzer's
+// lexicon for stem selection and part of speech.
+func (an *Analyzer) Lemmatize(word string) Lemma {
+	if word == "" {
+		return Lemma{}
+	}
+	if len(word) > maxWordBytes {
+		return Lemma{Form: word, Analysis: Analysis{Stem: word}}
+	}
+	word = azcase.ComposeNFC(word)
+	if m, ok := newScriptMap(word); ok {
+		return m.lemma(an.Lemmatize(m.latin))
+	}
+	if strings.ContainsAny(word, "-'\u2019\u02BC") {
+		stem := an.Stem(word)
+		return Lemma{
+			Form:     stem,
+			POS:      an.LookupPOS(azcase.ToLower(stem)),
+			Analysis: Analysis{Stem: stem, Compound: an.compound(stem)},
+		}
+	}
+
+	results, stem := an.analyzeStem(word)
+	a := an.analysisForStem(results, stem)
+	form := stem
+	pos := an.LookupPOS(azcase.ToLower(form))
+
+	// Verbal suffixes on a stem the dictionary does not list as a verb, or
+	// an unanalyzable unknown word, may hide a verb root behind buffer -y-
+	// or t→d voicing. Inflected forms listed in the dictionary as verbs
+	// (gedir) are reduced only to another dictionary verb, so that roots
+	// such as danış are not split into dan + -ış.
+	switch {
+	case (isVerbal(a) && pos != POSVerb) || (len(a.Morphemes) == 0 && pos == POSUnknown):
+		if root, ra, ok := an.findVerbRoot(results, true); ok {
+			form, a = root, ra
+		}
+	case len(a.Morphemes) == 0 && pos == POSVerb:
+		if root, ra, ok := an.findVerbRoot(results, false); ok {
+			form, a = root, ra
+		}
+	}
+	if isVerbal(a) {
+		pos = POSVerb
+	}
+	if pos == POSVerb {
+		form = citationForm(form)
+	}
+	return Lemma{Form: form, POS: pos, Analysis: a}
+}
+
+// analysisForStem returns the analysis whose stem Stem selected. A stem
+// restored by vowel insertion (ağız from ağzım) matches the contracted
+// analysis stem (ağz). Falls back to a bare analysis of stem.
+func (an *Analyzer) analysisForStem(results []Analysis, stem string) Analysis {
+	if i := an.stemIndex(results, stem); i >= 0 {
+		return results[i]
+	}
+	return Analysis{Stem: stem}
+}
+
+// stemIndex returns the index of the first analysis in results with the
+// given stem, or with its vowel-dropped form, or -1 if there is none.
+func (an *Analyzer) stemIndex(results []Analysis, stem string) int {
+	for i, a := range results {
+		if a.Stem == stem {
+			return i
+		}
+	}
+	lowStem := azcase.ToLower(stem)
+	for i, a := range results {
+		if len(a.Morphemes) > 0 && an.tryRestoreVowelDrop(azcase.ToLower(a.Stem)) == lowStem {
+			return i
+		}
+	}
+	return -1
+}
+
+// findVerbRoot searches verbal analyses for a stem, possibly repaired,
+// that the dictionary knows. Dictionary verbs are preferred; when anyPOS
+// is set, stems listed under another part of speech are accepted next
+// (oxu, yaz are listed as nouns).
+func (an *Analyzer) findVerbRoot(results []Analysis, anyPOS bool) (string, Analysis, bool) {
+	passes := []bool{true}
+	if anyPOS {
+		passes = append(passes, false)
+	}
+	for _, requireVerb := range passes {
+		for _, a := range results {
+			if !isVerbal(a) {
+				continue
+			}
+			for _, cand := range verbStemCandidates(a) {
+				low := azcase.ToLower(cand)
+				if !an.isKnownStem(low) {
+					continue
+				}
+				if !requireVerb || an.LookupPOS(low) == POSVerb {
+					return cand, a, true
+				}
+			}
+		}
+	}
+	return "", Analysis{}, false
+}
+
+// verbStemCandidates returns the analysis stem followed by its repairs
+// before a vowel-initial suffix: buffer -y- removed (oxuy → oxu) and
+// voiced d restored to t (ged → get).
+func verbStemCandidates(a Analysis) []string {
+	cands := []string{a.Stem}
+	rs := []rune(a.Stem)
+	if len(rs) < 2 || !isVowel(firstRune(a.Morphemes[0].Surface)) {
+		return cands
+	}
+	switch rs[len(rs)-1] {
+	case 'y', 'Y':
+		cands = append(cands, string(rs[:len(rs)-1]))
+	case 'd':
+		cands = append(cands, string(rs[:len(rs)-1])+"t")
+	case 'D':
+		cands = append(cands, string(rs[:len(rs)-1])+"T")
+	}
+	return cands
+}
+
+// isVerbal reports whether the first suffix of a is a verbal suffix,
+// which makes its stem a verb root.
+func isVerbal(a Analysis) bool {
+	if len(a.Morphemes) == 0 {
+		return false
+	}
+	tag := a.Morphemes[0].Tag
+	return tag >= vvoiceBase && tag < questBase
+}
+
+// citationForm appends the infinitive suffix to a verb stem:
+// -maq after a back vowel, -mək after a front vowel.
+func citationForm(stem string) string {
+	if isBackVowel(azcase.Lower(lastVowel(stem))) {
+		return stem + "maq"
+	}
+	return stem + "mək"
+}
@@ -0,0 +1,127 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestLemmatize(t *testing.T) {
+	tests := []struct {
+		word string
+		form string
+		pos  POS
+	}{
+		// -- Nouns and adjectives keep the stem --
+		{"kitablarımızdan", "kitab", POSNoun},
+		{"evlərdə", "ev", POSNoun},
+		{"gözəl", "gözəl", POSAdj},
+		{"gözəllik", "gözəl", POSAdj},
+		{"çox", "çox", POSAdv},
+
+		// -- Vowel drop restoration --
+		{"ağzım", "ağız", POSNoun},
+
+		// -- Verbs return the infinitive --
+		{"gəlmişdir", "gəlmək", POSVerb},
+		{"gəl", "gəlmək", POSVerb},
+		{"qaçdı", "qaçmaq", POSVerb},
+		{"danışır", "danışmaq", POSVerb},
+		{"dedi", "demək", POSVerb},
+		{"gələn", "gəlmək", POSVerb},
+
+		// -- Verbal suffix overrides a non-verb dictionary POS --
+		{"yazdım", "yazmaq", POSVerb},
+
+		// -- Root repair: buffer y and t→d voicing --
+		{"oxuyuram", "oxumaq", POSVerb},
+		{"işləyir", "işləmək", POSVerb},
+		{"edib", "etmək", POSVerb},
+		{"gedir", "getmək", POSVerb},
+
+		// -- Whole-word verb roots are not split --
+		{"danış", "danışmaq", POSVerb},
+
+		// -- Case preservation --
+		{"Gəldim", "Gəlmək", POSVerb},
+
+		// -- Unknown, hyphen, apostrophe --
+		{"xyzabc", "xyzabc", POSUnknown},
+		{"sosial-iqtisadi", "sosial-iqtisadi", POSUnknown},
+		{"Bakı'nın", "Bakı", LookupPOS("bakı")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := Lemmatize(tt.word)
+			if got.Form != tt.form || got.POS != tt.pos {
+				t.Errorf("Lemmatize(%q) = {%q %v}, want {%q %v}\n  analysis: %v",
+					tt.word, got.Form, got.POS, tt.form, tt.pos, got.Analysis)
+			}
+		})
+	}
+}
+
+func TestLemmatizeAnalysis(t *testing.T) {
+	got := Lemmatize("kitablarımızdan")
+	want := "kitab[Plural:lar|Poss1Pl:ımız|CaseAbl:dan]"
+	if got.Analysis.String() != want {
+		t.Errorf("Lemmatize(kitablarımızdan).Analysis = %v, want %s", got.Analysis, want)
+	}
+	if got := Lemmatize("oxuyuram").Analysis.Stem; got != "oxuy" {
+		t.Errorf("Lemmatize(oxuyuram).Analysis.Stem = %q, want %q", got, "oxuy")
+	}
+}
+
+func TestLemmatizeEdgeCases(t *testing.T) {
+	if got := Lemmatize(""); got.Form != "" || got.POS != POSUnknown || got.Analysis.Stem != "" {
+		t.Errorf("Lemmatize(\"\") = %+v, want zero Lemma", got)
+	}
+	long := string(make([]byte, maxWordBytes+1))
+	if got := Lemmatize(long); got.Form != long || got.POS != POSUnknown {
+		t.Errorf("Lemmatize(too_long) changed form or POS: %v", got.POS)
+	}
+}
+
+func TestCitationForm(t *testing.T) {
+	tests := []struct{ stem, want string }{
+		{"yaz", "yazmaq"},
+		{"gəl", "gəlmək"},
+		{"oxu", "oxumaq"},
+		{"gör", "görmək"},
+		{"işlə", "işləmək"},
+	}
+	for _, tt := range tests {
+		if got := citationForm(tt.stem); got != tt.want {
+			t.Errorf("citationForm(%q) = %q, want %q", tt.stem, got, tt.want)
+		}
+	}
+}
+
+func TestLemmaJSON(t *testing.T) {
+	data, err := json.Marshal(Lemmatize("evlər"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"form":"ev","pos":"Noun","analysis":{"stem":"ev","morphemes":[{"surface":"lər","tag":"Plural"}],"score":0.9611}}`
+	if string(data) != want {
+		t.Errorf("json.Marshal(Lemmatize(evlər)) = %s, want %s", data, want)
+	}
+}
+
+func BenchmarkLemmatize(b *testing.B) {
+	for b.Loop() {
+		Lemmatize("kitablarımızdan")
+	}
+}
+
+func ExampleLemmatize() {
+	for _, w := range []string{"kitablarımızdan", "yazdım", "gedir"} {
+		l := Lemmatize(w)
+		fmt.Println(l.Form, l.POS)
+	}
+	// Output:
+	// kitab Noun
+	// yazmaq Verb
+	// getmək Verb
+}
@@ -9,11 +9,38 @@
 //   - Convenience: Stem returns just the base form string, and Stems
 //     is a batch wrapper for use with tokenizer.Words().
 //
+// Lemmatize sits between the two: it returns the stem chosen by Stem as a
+// dictionary lemma with its part of speech, with verbs in the -maq/-mək
+// infinitive form.
+//
+// Analyze scores each analysis from dictionary membership, stem frequency
+// and suffix-chain plausibility, and sorts by that confidence.
+//
+// Stems made of several words (dəmiryol, kitab-mitab, qap-qara) carry
+// their parts and reduplication type in Analysis.Compound.
+//
+// Personal and demonstrative pronouns (mən, biz, o, bu, ...) decline
+// irregularly and are analyzed from a fixed table instead of the FSM.
+//
+// Lemma.Feats and POS.UPOS map analyses to Universal Dependencies
+// features, and WriteCoNLLU exports tokenized, analysed text as CoNLL-U.
+// Analysis.Gloss and GlossSentence render Leipzig-style interlinear
+// glosses (kitab-lar-ımız-dan / kitab-PL-POSS.1PL-ABL).
+//
+// Generate runs the same suffix table in the opposite direction, building
+// an inflected surface form from a stem and a tag sequence. Paradigm uses
+// it to expand a dictionary lemma into its full inflection table.
+//
 // The analyzer uses a table-driven morphotactic state machine with
 // backtracking. It validates vowel harmony, consonant assimilation,
 // and suffix ordering constraints without requiring a dictionary.
 //
-// All functions are safe for concurrent use by multiple goroutines.
+// NewAnalyzer returns an Analyzer with its own lexicon layered over the
+// embedded dictionary (extra stems, blocked stems, whole-word exceptions).
+// The package-level functions use a default Analyzer with no overrides.
+//
+// All functions and Analyzer methods are safe for concurrent use by
+// multiple goroutines.
 //
 // Known limitations:
 //
@@ -23,15 +50,20 @@
 //   - oxu- class verbs absorb buffer -y- into the stem (oxuy-).
 //   - Morpheme tagging may prefer deeper parses over correct ones
 //     when multiple analyses tie (e.g. oxuyursan VoiceCaus vs TensePresent).
+//     AnalyzeSentence breaks such ties using neighbouring words.
 //
-// Input must be Azerbaijani Latin in NFC form.
-// Use translit.CyrillicToLatin to convert Cyrillic input.
+// Analyze, Stem, StemWith and Lemmatize take Azerbaijani Latin or
+// Cyrillic words and answer in the script of the input; Cyrillic is
+// analysed through translit.CyrillicToLatin. Other functions expect
+// Azerbaijani Latin. Input is normalized to NFC.
 package morph
 
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/az-ai-labs/az-lang-nlp/azcase"
 )
@@ -46,6 +78,7 @@ const (
 	vmoodBase  = 330
 	vpartBase  = 340
 	vpersBase  = 350
+	vconvBase  = 360
 	questBase  = 400
 )
 
@@ -72,11 +105,18 @@ const (
 )
 
 const (
-	DerivAgent    MorphTag = derivBase + iota // -ci/-ci, -cu/-cu (agent noun)
-	DerivAbstract                             // -liq/-lik, -luq/-luk (abstract noun)
-	DerivPriv                                 // -siz/-siz (privative)
-	DerivPoss                                 // -li/-li (possessive adjective)
-	DerivVerb                                 // -la/-le (denominal verb)
+	DerivAgent     MorphTag = derivBase + iota // -ci/-ci, -cu/-cu (agent noun)
+	DerivAbstract                              // -liq/-lik, -luq/-luk (abstract noun)
+	DerivPriv                                  // -siz/-siz (privative)
+	DerivPoss                                  // -li/-li (possessive adjective)
+	DerivVerb                                  // -laş/-ləş (denominal verb, become)
+	DerivFellow                                // -daş (fellow, companion: yoldaş, vətəndaş)
+	DerivPlace                                 // -xana, -stan/-istan (place: kitabxana, Gürcüstan)
+	DerivDim                                   // -cıq/-cik/-cuq/-cük (diminutive)
+	DerivEquative                              // -ca/-cə (equative, adverbial: uşaqca, türkcə)
+	DerivRel                                   // -sal/-səl (relational adjective)
+	DerivVerbAgent                             // -ıcı/-ici/-ucu/-ücü (deverbal agent: dinləyici)
+	DerivVerbRefl                              // -lan/-lən (denominal verb, be/get: evlən)
 )
 
 const (
@@ -87,7 +127,7 @@ const (
 	VoicePass   MorphTag = vvoiceBase + iota // -il/-il, -ul/-ul, -n (passive)
 	VoiceReflex                              // -in/-in, -un/-un (reflexive)
 	VoiceRecip                               // -is/-is, -us/-us (reciprocal)
-	VoiceCaus                                // -t/-dir/-dir (causative)
+	VoiceCaus                                // -t, -ır/-ir, -dır/-dir (causative)
 )
 
 const (
@@ -110,9 +150,11 @@ const (
 )
 
 const (
-	Participle    MorphTag = vpartBase + iota // -an/-en (present participle)
-	ParticipleAdj                             // -mish/-mish (past participle adjective)
-	Gerund                                    // -maq/-mek (verbal noun/infinitive)
+	Participle       MorphTag = vpartBase + iota // -an/-en (present participle)
+	ParticipleAdj                                // -mish/-mish (past participle adjective)
+	Gerund                                       // -maq/-mek (verbal noun/infinitive)
+	ParticiplePast                               // -dıq/-dik, -duq/-dük (relative participle, before possessive)
+	ParticipleFuture                             // -acaq/-əcək (relative participle, before possessive)
 )
 
 const (
@@ -123,6 +165,15 @@ const (
 	Pers3                               // unmarked or -dir/-dir
 )
 
+const (
+	ConverbSeq     MorphTag = vconvBase + iota // -ıb/-ib, -ub/-üb (sequential, having done)
+	ConverbManner                              // -araq/-ərək (manner, by doing)
+	ConverbWhile                               // -anda/-əndə (simultaneous, when doing)
+	ConverbWithout                             // -madan/-mədən (without doing)
+	ConverbUpon                                // -ınca/-incə, -unca/-üncə (until, upon doing)
+	ConverbWhen                                // -dıqda/-dikdə (when, after doing)
+)
+
 const (
 	Question MorphTag = questBase // -mi/-mi, -mu/-mu (question particle)
 )
@@ -150,6 +201,14 @@ var morphTagNames = map[MorphTag]string{
 	DerivPoss:     "DerivPoss",
 	DerivVerb:     "DerivVerb",
 
+	DerivFellow:    "DerivFellow",
+	DerivPlace:     "DerivPlace",
+	DerivDim:       "DerivDim",
+	DerivEquative:  "DerivEquative",
+	DerivRel:       "DerivRel",
+	DerivVerbAgent: "DerivVerbAgent",
+	DerivVerbRefl:  "DerivVerbRefl",
+
 	Copula: "Copula",
 
 	VoicePass:   "VoicePass",
@@ -170,9 +229,11 @@ var morphTagNames = map[MorphTag]string{
 	MoodCond:  "MoodCond",
 	MoodImper: "MoodImper",
 
-	Participle:    "Participle",
-	ParticipleAdj: "ParticipleAdj",
-	Gerund:        "Gerund",
+	Participle:       "Participle",
+	ParticipleAdj:    "ParticipleAdj",
+	Gerund:           "Gerund",
+	ParticiplePast:   "ParticiplePast",
+	ParticipleFuture: "ParticipleFuture",
 
 	Pers1Sg: "Pers1Sg",
 	Pers2Sg: "Pers2Sg",
@@ -180,6 +241,13 @@ var morphTagNames = map[MorphTag]string{
 	Pers2Pl: "Pers2Pl",
 	Pers3:   "Pers3",
 
+	ConverbSeq:     "ConverbSeq",
+	ConverbManner:  "ConverbManner",
+	ConverbWhile:   "ConverbWhile",
+	ConverbWithout: "ConverbWithout",
+	ConverbUpon:    "ConverbUpon",
+	ConverbWhen:    "ConverbWhen",
+
 	Question: "Question",
 }
 
@@ -206,6 +274,14 @@ var morphTagFromName = map[string]MorphTag{
 	"DerivPoss":     DerivPoss,
 	"DerivVerb":     DerivVerb,
 
+	"DerivFellow":    DerivFellow,
+	"DerivPlace":     DerivPlace,
+	"DerivDim":       DerivDim,
+	"DerivEquative":  DerivEquative,
+	"DerivRel":       DerivRel,
+	"DerivVerbAgent": DerivVerbAgent,
+	"DerivVerbRefl":  DerivVerbRefl,
+
 	"Copula": Copula,
 
 	"VoicePass":   VoicePass,
@@ -226,9 +302,11 @@ var morphTagFromName = map[string]MorphTag{
 	"MoodCond":  MoodCond,
 	"MoodImper": MoodImper,
 
-	"Participle":    Participle,
-	"ParticipleAdj": ParticipleAdj,
-	"Gerund":        Gerund,
+	"Participle":       Participle,
+	"ParticipleAdj":    ParticipleAdj,
+	"Gerund":           Gerund,
+	"ParticiplePast":   ParticiplePast,
+	"ParticipleFuture": ParticipleFuture,
 
 	"Pers1Sg": Pers1Sg,
 	"Pers2Sg": Pers2Sg,
@@ -236,30 +314,125 @@ var morphTagFromName = map[string]MorphTag{
 	"Pers2Pl": Pers2Pl,
 	"Pers3":   Pers3,
 
+	"ConverbSeq":     ConverbSeq,
+	"ConverbManner":  ConverbManner,
+	"ConverbWhile":   ConverbWhile,
+	"ConverbWithout": ConverbWithout,
+	"ConverbUpon":    ConverbUpon,
+	"ConverbWhen":    ConverbWhen,
+
 	"Question": Question,
 }
 
+// morphTagGlosses maps MorphTag values to Leipzig glossing labels.
+// Grammatical categories use the standard abbreviations; derivations
+// without one are glossed with a short label of their meaning.
+var morphTagGlosses = map[MorphTag]string{
+	Plural:  "PL",
+	Poss1Sg: "POSS.1SG",
+	Poss2Sg: "POSS.2SG",
+	Poss3Sg: "POSS.3SG",
+	Poss1Pl: "POSS.1PL",
+	Poss2Pl: "POSS.2PL",
+	Poss3Pl: "POSS.3PL",
+
+	CaseGen: "GEN",
+	CaseDat: "DAT",
+	CaseAcc: "ACC",
+	CaseLoc: "LOC",
+	CaseAbl: "ABL",
+	CaseIns: "INS",
+
+	DerivAgent:    "AGT",
+	DerivAbstract: "ABST",
+	DerivPriv:     "PRIV",
+	DerivPoss:     "PROP",
+	DerivVerb:     "VBZ",
+
+	DerivFellow:    "fellow",
+	DerivPlace:     "place",
+	DerivDim:       "DIM",
+	DerivEquative:  "EQU",
+	DerivRel:       "ADJZ",
+	DerivVerbAgent: "AGT",
+	DerivVerbRefl:  "VBZ",
+
+	Copula: "COP",
+
+	VoicePass:   "PASS",
+	VoiceReflex: "REFL",
+	VoiceRecip:  "RECP",
+	VoiceCaus:   "CAUS",
+
+	Negation: "NEG",
+
+	TensePastDef:   "PST",
+	TensePastIndef: "EVID",
+	TensePresent:   "PRS",
+	TenseFuture:    "FUT",
+	TenseAorist:    "AOR",
+	TensePastEvi:   "PRF",
+
+	MoodOblig: "NEC",
+	MoodCond:  "COND",
+	MoodImper: "IMP",
+
+	Participle:       "PTCP",
+	ParticipleAdj:    "PTCP.PRF",
+	Gerund:           "INF",
+	ParticiplePast:   "PTCP.PST",
+	ParticipleFuture: "PTCP.FUT",
+
+	Pers1Sg: "1SG",
+	Pers2Sg: "2SG",
+	Pers1Pl: "1PL",
+	Pers2Pl: "2PL",
+	Pers3:   "3",
+
+	ConverbSeq:     "CVB",
+	ConverbManner:  "CVB.MNR",
+	ConverbWhile:   "CVB.SIM",
+	ConverbWithout: "CVB.NEG",
+	ConverbUpon:    "CVB.TERM",
+	ConverbWhen:    "CVB.TEMP",
+
+	Question: "Q",
+}
+
 // productiveTags lists morpheme tags that indicate a genuine productive
 // morphological decomposition. When the whole word is a known dictionary
 // stem but a shorter known stem with one of these tags exists, the shorter
 // stem is preferred. Case suffixes, possessives, and Negation are excluded
 // to prevent over-stemming (e.g. ana→an, alma→al).
 var productiveTags = map[MorphTag]bool{
-	TensePastDef:   true,
-	TensePastIndef: true,
-	TensePresent:   true,
-	TenseFuture:    true,
-	TenseAorist:    true,
-	TensePastEvi:   true,
-	MoodOblig:      true,
-	Participle:     true,
-	ParticipleAdj:  true,
-	Gerund:         true,
-	DerivAgent:     true,
-	DerivAbstract:  true,
-	DerivPriv:      true,
-	DerivPoss:      true,
-	DerivVerb:      true,
+	TensePastDef:     true,
+	TensePastIndef:   true,
+	TensePresent:     true,
+	TenseFuture:      true,
+	TenseAorist:      true,
+	TensePastEvi:     true,
+	MoodOblig:        true,
+	Participle:       true,
+	ParticipleAdj:    true,
+	Gerund:           true,
+	ParticiplePast:   true,
+	ParticipleFuture: true,
+	ConverbSeq:       true,
+	ConverbManner:    true,
+	ConverbWhile:     true,
+	ConverbWithout:   true,
+	ConverbUpon:      true,
+	ConverbWhen:      true,
+	DerivAgent:       true,
+	DerivAbstract:    true,
+	DerivPriv:        true,
+	DerivPoss:        true,
+	DerivVerb:        true,
+	DerivDim:         true,
+	// The diminutive -cıq stays productive (evcik, pulcuq), but the other
+	// newer derivations (-daş, -xana, -stan, -ca, -sal, -ıcı, -lan) are
+	// excluded: they mostly occur in lexicalized words (yoldaş,
+	// kitabxana, Gürcüstan) that are their own dictionary entries.
 	// Voice suffixes are excluded: they are derivational and create new
 	// lexical items (danış "speak" ≠ dan "dawn" + -ış). When the whole
 	// word is a known dictionary stem, the whole-word interpretation wins.
@@ -274,6 +447,15 @@ func (t MorphTag) String() string {
 	return fmt.Sprintf("MorphTag(%d)", int(t))
 }
 
+// Gloss returns the Leipzig glossing label of the morpheme tag
+// (e.g. "POSS.1PL"), or its name if it has none.
+func (t MorphTag) Gloss() string {
+	if g, ok := morphTagGlosses[t]; ok {
+		return g
+	}
+	return t.String()
+}
+
 // MarshalJSON encodes the morph tag as a JSON string (e.g. "Plural").
 func (t MorphTag) MarshalJSON() ([]byte, error) {
 	return json.Marshal(t.String())
@@ -301,8 +483,10 @@ type Morpheme struct {
 
 // Analysis represents a complete morphological analysis with stem and suffix chain.
 type Analysis struct {
-	Stem      string     `json:"stem"`      // The base form
-	Morphemes []Morpheme `json:"morphemes"` // Ordered list of suffixes
+	Stem      string     `json:"stem"`               // The base form
+	Morphemes []Morpheme `json:"morphemes"`          // Ordered list of suffixes
+	Compound  *Compound  `json:"compound,omitempty"` // Parts of a compound or reduplicated stem
+	Score     float64    `json:"score,omitempty"`    // Confidence in [0, 1], set by Analyze
 }
 
 // String returns a debug representation, e.g. kitab[Plural:lar|Poss1Pl:imiz|CaseAbl:dan].
@@ -332,16 +516,22 @@ const maxWordBytes = 256
 // sit close to the verb root in morphotactic order, so their presence
 // indicates a deeper (more correct) decomposition than a longer stem
 // that absorbed part of the suffix (e.g. gəlmə+di vs gəl+mə+di).
+// Converbs qualify too when the stem is a dictionary verb, since
+// participles and verbal nouns are often listed as whole words
+// (gələn+də vs gəl+əndə, gəlmə+dən vs gəl+mədən). A converb stem may
+// hide the dictionary verb behind buffer -y- (dey+incə for de).
 // Returns the shortest such stem, or "" if none found.
-func findDeepVerbStem(results []Analysis) string {
+func (an *Analyzer) findDeepVerbStem(results []Analysis) string {
 	var best string
 	bestLen := maxWordBytes
 	for _, a := range results {
-		if len(a.Morphemes) == 0 || !isKnownStem(azcase.ToLower(a.Stem)) {
+		if len(a.Morphemes) == 0 {
 			continue
 		}
+		low := azcase.ToLower(a.Stem)
 		tag := a.Morphemes[0].Tag
-		if tag == Negation || tag == MoodOblig || tag == MoodCond {
+		if (an.isKnownStem(low) && (tag == Negation || tag == MoodOblig || tag == MoodCond)) ||
+			(isConverbTag(tag) && an.hasVerbRoot(a)) {
 			n := len([]rune(a.Stem))
 			if n < bestLen {
 				bestLen = n
@@ -352,15 +542,26 @@ func findDeepVerbStem(results []Analysis) string {
 	return best
 }
 
+// hasVerbRoot reports whether the stem of a, possibly repaired by
+// verbStemCandidates, is a dictionary verb.
+func (an *Analyzer) hasVerbRoot(a Analysis) bool {
+	for _, cand := range verbStemCandidates(a) {
+		if an.LookupPOS(azcase.ToLower(cand)) == POSVerb {
+			return true
+		}
+	}
+	return false
+}
+
 // findVowelDropStem searches analyses for an unknown stem that can be
 // restored to a known dictionary form via vowel insertion (e.g. oğl→oğul).
 // Only attempts restoration on stems not already in the dictionary, so that
 // plurals like qızlar→qız are not incorrectly restored (qızl→qızıl).
 // Preserves original casing of the first character.
-func findVowelDropStem(results []Analysis) string {
+func (an *Analyzer) findVowelDropStem(results []Analysis) string {
 	for _, a := range results {
-		if len(a.Morphemes) > 0 && !isKnownStem(azcase.ToLower(a.Stem)) {
-			if restored := tryRestoreVowelDrop(azcase.ToLower(a.Stem)); restored != "" {
+		if len(a.Morphemes) > 0 && !an.isKnownStem(azcase.ToLower(a.Stem)) {
+			if restored := an.tryRestoreVowelDrop(azcase.ToLower(a.Stem)); restored != "" {
 				rOrig := []rune(a.Stem)
 				rRest := []rune(restored)
 				if len(rOrig) > 0 && len(rRest) > 0 && rOrig[0] != azcase.Lower(rOrig[0]) {
@@ -374,24 +575,83 @@ func findVowelDropStem(results []Analysis) string {
 	return ""
 }
 
+// nominalDerivs lists the productive derivations that attach to
+// nominals only.
+var nominalDerivs = map[MorphTag]bool{
+	DerivAgent:    true,
+	DerivAbstract: true,
+	DerivPriv:     true,
+	DerivPoss:     true,
+}
+
+// isNominal reports whether the known lowercase stem s may carry a
+// nominal derivation: it is not a verb (ölçü is not öl+çü) nor one of the
+// two-letter particles and conjunctions the dictionary files as adverbs
+// (dəli is not də+li).
+func (an *Analyzer) isNominal(s string) bool {
+	switch an.LookupPOS(s) {
+	case POSVerb:
+		return false
+	case POSAdv:
+		return utf8.RuneCountInString(s) > 2
+	}
+	return true
+}
+
+// findLoanwordStem returns the stem of the first inflected analysis whose
+// stem is a known loanword of the harmony exception list, so that hallar
+// is hal+lar rather than hall+ar. Derived words (iştirakçılar) keep their
+// own stem. Returns "" if there is none.
+func (an *Analyzer) findLoanwordStem(results []Analysis) string {
+	for _, a := range results {
+		s := azcase.ToLower(a.Stem)
+		if len(a.Morphemes) > 0 && !isDerivTag(a.Morphemes[0].Tag) && isHarmonyException(s) && an.isKnownStem(s) {
+			return a.Stem
+		}
+	}
+	return ""
+}
+
 // findProductiveStem checks whether a known whole-word has a shorter known
 // stem with productive morphemes (verbal tenses, derivational suffixes, etc.).
 // This allows stemming of words like gələcək→gəl (TenseFuture) and
 // gözlük→göz (DerivAbstract) even when the whole word is in the dictionary.
 // Returns the shorter stem, or "" if no productive decomposition exists.
-func findProductiveStem(results []Analysis, word string) string {
+func (an *Analyzer) findProductiveStem(results []Analysis, word string) string {
 	wordLower := azcase.ToLower(word)
 	for _, a := range results {
 		if len(a.Morphemes) == 0 {
 			continue
 		}
 		stemLower := azcase.ToLower(a.Stem)
-		if stemLower == wordLower || !isKnownStem(stemLower) {
+		if stemLower == wordLower || !an.isKnownStem(stemLower) {
 			continue
 		}
 		// Require at least 2-rune surface to avoid false positives from
-		// single-char suffixes like -t (VoiceCaus) splitting paltar→pal.
-		if len([]rune(a.Morphemes[0].Surface)) >= 2 && productiveTags[a.Morphemes[0].Tag] {
+		// single-char suffixes like -t (VoiceCaus) splitting paltar→pal,
+		// and a nominal base for nominal derivations.
+		if len([]rune(a.Morphemes[0].Surface)) >= 2 && productiveTags[a.Morphemes[0].Tag] &&
+			(!nominalDerivs[a.Morphemes[0].Tag] || an.isNominal(stemLower)) {
+			return a.Stem
+		}
+	}
+	return ""
+}
+
+// findDerivedVerbStem checks whether the known stem of the analysis known
+// is a verb derived with -laş (and voice suffixes) from a shorter known
+// stem, as yaxşılaşdır is from yaxşı. Returns that stem, or "" if no
+// analysis decomposes the stem of known that way.
+func (an *Analyzer) findDerivedVerbStem(results []Analysis, known Analysis) string {
+	tail := known.Morphemes
+	for _, a := range results {
+		n := len(a.Morphemes) - len(tail)
+		if n <= 0 || a.Morphemes[0].Tag != DerivVerb ||
+			!slices.Equal(a.Morphemes[n:], tail) || !an.isKnownStem(azcase.ToLower(a.Stem)) {
+			continue
+		}
+		voice := !slices.ContainsFunc(a.Morphemes[1:n], func(m Morpheme) bool { return !isVoiceTag(m.Tag) })
+		if voice && an.isNominal(azcase.ToLower(a.Stem)) {
 			return a.Stem
 		}
 	}
@@ -402,35 +662,82 @@ func findProductiveStem(results []Analysis, word string) string {
 // Returns the original word if it cannot be analyzed or exceeds maxWordBytes.
 // Handles hyphens by stemming each part separately and rejoining.
 // Handles apostrophes by returning the part before the first apostrophe.
+// Cyrillic input gives a Cyrillic stem.
 func Stem(word string) string {
+	return defaultAnalyzer.Stem(word)
+}
+
+// Stem is like the package-level Stem but consults the Analyzer's
+// lexicon. An exception entry for word is returned as is.
+func (an *Analyzer) Stem(word string) string {
 	if word == "" || len(word) > maxWordBytes {
 		return word
 	}
 	word = azcase.ComposeNFC(word)
+	if m, ok := newScriptMap(word); ok {
+		return m.piece(an.Stem(m.latin), 0)
+	}
+	if stem, ok := an.directStem(word); ok {
+		return stem
+	}
+	return an.selectStem(word, an.candidates(word))
+}
+
+// directStem returns the stem of an NFC word that is decided without
+// ranking analyses: exception entries, irregular pronoun forms, and
+// hyphenated and apostrophe-suffixed words.
+func (an *Analyzer) directStem(word string) (string, bool) {
+	if stem, ok := an.exception(word); ok {
+		return stem, true
+	}
+	if a, ok := an.pronounAnalysis(word); ok {
+		return a.Stem, true
+	}
 
 	// Handle hyphens: split, stem each part, rejoin
 	if idx := strings.Index(word, "-"); idx > 0 && idx < len(word)-1 {
+		if stem, ok := an.mReduplicationStem(word[:idx], word[idx+1:]); ok {
+			return stem, true
+		}
 		parts := strings.Split(word, "-")
 		for i, p := range parts {
-			parts[i] = Stem(p)
+			parts[i] = an.Stem(p)
 		}
-		return strings.Join(parts, "-")
+		return strings.Join(parts, "-"), true
 	}
 
 	// Handle apostrophes: split at first apostrophe, return pre-apostrophe part
 	for i, r := range word {
 		if r == '\'' || r == '\u2019' || r == '\u02BC' {
 			if i > 0 {
-				return word[:i]
+				return word[:i], true
 			}
-			return word // apostrophe at start, return unchanged
+			return word, true // apostrophe at start, return unchanged
 		}
 	}
+	return "", false
+}
 
-	results := Analyze(word)
+// mReduplicationStem returns the stem of an m-reduplication whose second
+// part echoes the first with the same suffixes (alma-malma,
+// kitabları-mitabları → kitab-mitab), which stemming each part alone would
+// split wrongly (malma → mal+ma).
+func (an *Analyzer) mReduplicationStem(first, second string) (string, bool) {
+	if !isMReduplication([]rune(azcase.ToLower(first)), []rune(azcase.ToLower(second))) {
+		return "", false
+	}
+	stem := an.Stem(first)
+	suffix, ok := strings.CutPrefix(first, stem)
+	if !ok || !strings.HasSuffix(second, suffix) {
+		return "", false
+	}
+	return stem + "-" + strings.TrimSuffix(second, suffix), true
+}
 
+// selectStem picks the stem of word from its candidate analyses.
+func (an *Analyzer) selectStem(word string, results []Analysis) string {
 	// Four-pass dictionary-aware stem selection.
-	wordKnown := isKnownStem(azcase.ToLower(word))
+	wordKnown := an.isKnownStem(azcase.ToLower(word))
 	// Pass 1: prefer analysis with morphemes AND known dictionary stem,
 	// but skip when the whole word is also known (avoids stripping real
 	// stems like ana->an where both are dictionary entries).
@@ -439,18 +746,24 @@ func Stem(word string) string {
 		// (gəlmə, yazma) is in the dictionary but the real verb root
 		// (gəl, yaz) should be preferred. Negation, MoodOblig and MoodCond
 		// are close-to-root suffixes that indicate a deeper decomposition.
-		if deep := findDeepVerbStem(results); deep != "" {
+		if deep := an.findDeepVerbStem(results); deep != "" {
 			return deep
 		}
+		if loan := an.findLoanwordStem(results); loan != "" {
+			return loan
+		}
 		for _, a := range results {
-			if len(a.Morphemes) > 0 && isKnownStem(azcase.ToLower(a.Stem)) {
+			if len(a.Morphemes) > 0 && an.isKnownStem(azcase.ToLower(a.Stem)) {
+				if base := an.findDerivedVerbStem(results, a); base != "" {
+					return base
+				}
 				return a.Stem
 			}
 		}
 	}
 	// Pass 2: vowel drop restoration (oğl→oğul, aln→alın).
 	if !wordKnown {
-		if restored := findVowelDropStem(results); restored != "" {
+		if restored := an.findVowelDropStem(results); restored != "" {
 			return restored
 		}
 	}
@@ -458,7 +771,7 @@ func Stem(word string) string {
 	// it unless a productive decomposition (verbal/derivational suffix with
 	// a known shorter stem) exists.
 	if wordKnown {
-		if prod := findProductiveStem(results, word); prod != "" {
+		if prod := an.findProductiveStem(results, word); prod != "" {
 			return prod
 		}
 		return word
@@ -473,19 +786,72 @@ func Stem(word string) string {
 }
 
 // Analyze performs morphological analysis on an Azerbaijani word.
-// Returns all possible analyses (stems with suffix chains).
+// Returns all possible analyses (stems with suffix chains), each with a
+// confidence Score, sorted by descending score. The analysis whose stem
+// Stem selects comes first. Scores of one word sum to 1, so callers can
+// keep the analyses above a threshold by cutting the slice at the first
+// lower score.
 // Returns nil for empty input.
 // Returns a single-element slice with the original word as stem if analysis fails.
+// Cyrillic input gives Cyrillic stems and morpheme surfaces.
 func Analyze(word string) []Analysis {
+	return defaultAnalyzer.Analyze(word)
+}
+
+// Analyze is like the package-level Analyze but consults the Analyzer's
+// lexicon. Analyses with a blocked stem are dropped, except the bare-stem
+// interpretation of the whole word. When word has an exception entry,
+// analyses with the exception stem are moved to the front. Irregular
+// pronoun forms (mənim, ona, bunlar) put the pronoun analysis first.
+// Stems that are compounds or reduplications carry their parts in
+// Compound.
+func (an *Analyzer) Analyze(word string) []Analysis {
+	results, _ := an.analyzeStem(word)
+	return results
+}
+
+// analyzeStem returns the result of both Analyze and Stem for word,
+// selecting the stem once for ranking and for the caller.
+func (an *Analyzer) analyzeStem(word string) ([]Analysis, string) {
 	if word == "" {
-		return nil
+		return nil, word
 	}
 	if len(word) > maxWordBytes {
-		return []Analysis{{Stem: word}}
+		return []Analysis{{Stem: word, Score: 1}}, word
 	}
 	word = azcase.ComposeNFC(word)
+	if m, ok := newScriptMap(word); ok {
+		results, stem := an.analyzeStem(m.latin)
+		return m.analyses(results), m.piece(stem, 0)
+	}
+	results := an.candidates(word)
+	stem, ok := an.directStem(word)
+	if !ok {
+		stem = an.selectStem(word, results)
+	}
+	return an.withCompounds(an.rank(results, stem)), stem
+}
+
+// withCompounds sets the Compound of each analysis, looking each distinct
+// stem up once. Stem does not need them, so candidates leaves them out.
+func (an *Analyzer) withCompounds(results []Analysis) []Analysis {
+next:
+	for i := range results {
+		for j := range i {
+			if results[j].Stem == results[i].Stem {
+				results[i].Compound = results[j].Compound
+				continue next
+			}
+		}
+		results[i].Compound = an.compound(results[i].Stem)
+	}
+	return results
+}
 
-	results := analyze(word)
+// candidates returns the unscored analyses of an NFC word in FSM order,
+// with irregular pronoun and exception analyses moved to the front.
+func (an *Analyzer) candidates(word string) []Analysis {
+	results := an.analyze(word)
 	// Always include bare-stem interpretation.
 	if isValidStem(azcase.ToLower(word)) {
 		results = append(results, Analysis{Stem: word})
@@ -493,6 +859,22 @@ func Analyze(word string) []Analysis {
 	if len(results) == 0 {
 		return []Analysis{{Stem: word}}
 	}
+	if a, ok := an.pronounAnalysis(word); ok {
+		results = dedup(append([]Analysis{a}, results...))
+	}
+	if stem, ok := an.exception(word); ok {
+		low := azcase.ToLower(stem)
+		slices.SortStableFunc(results, func(a, b Analysis) int {
+			ka, kb := azcase.ToLower(a.Stem) == low, azcase.ToLower(b.Stem) == low
+			switch {
+			case ka == kb:
+				return 0
+			case ka:
+				return -1
+			}
+			return 1
+		})
+	}
 	return results
 }
 
@@ -500,12 +882,17 @@ func Analyze(word string) []Analysis {
 // Designed to be used with tokenizer.Words().
 // Returns nil if the input is nil.
 func Stems(words []string) []string {
+	return defaultAnalyzer.Stems(words)
+}
+
+// Stems is like the package-level Stems but uses the Analyzer's lexicon.
+func (an *Analyzer) Stems(words []string) []string {
 	if words == nil {
 		return nil
 	}
 	out := make([]string, len(words))
 	for i, w := range words {
-		out[i] = Stem(w)
+		out[i] = an.Stem(w)
 	}
 	return out
 }
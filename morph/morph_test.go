@@ -5,6 +5,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 	"unicode/utf8"
@@ -63,6 +64,12 @@ func TestIsVowel(t *testing.T) {
 			}
 		})
 	}
+	// isVowel must agree with the vowel maps.
+	for r := rune(0); r < 0x300; r++ {
+		if isVowel(r) != (backVowels[r] || frontVowels[r]) {
+			t.Errorf("isVowel(%q) = %v, disagrees with the vowel maps", r, isVowel(r))
+		}
+	}
 }
 
 func TestIsBackVowel(t *testing.T) {
@@ -348,6 +355,13 @@ func TestMorphTagString(t *testing.T) {
 		{DerivPriv, "DerivPriv"},
 		{DerivPoss, "DerivPoss"},
 		{DerivVerb, "DerivVerb"},
+		{DerivFellow, "DerivFellow"},
+		{DerivPlace, "DerivPlace"},
+		{DerivDim, "DerivDim"},
+		{DerivEquative, "DerivEquative"},
+		{DerivRel, "DerivRel"},
+		{DerivVerbAgent, "DerivVerbAgent"},
+		{DerivVerbRefl, "DerivVerbRefl"},
 		{Copula, "Copula"},
 		{VoicePass, "VoicePass"},
 		{VoiceReflex, "VoiceReflex"},
@@ -366,11 +380,19 @@ func TestMorphTagString(t *testing.T) {
 		{Participle, "Participle"},
 		{ParticipleAdj, "ParticipleAdj"},
 		{Gerund, "Gerund"},
+		{ParticiplePast, "ParticiplePast"},
+		{ParticipleFuture, "ParticipleFuture"},
 		{Pers1Sg, "Pers1Sg"},
 		{Pers2Sg, "Pers2Sg"},
 		{Pers1Pl, "Pers1Pl"},
 		{Pers2Pl, "Pers2Pl"},
 		{Pers3, "Pers3"},
+		{ConverbSeq, "ConverbSeq"},
+		{ConverbManner, "ConverbManner"},
+		{ConverbWhile, "ConverbWhile"},
+		{ConverbWithout, "ConverbWithout"},
+		{ConverbUpon, "ConverbUpon"},
+		{ConverbWhen, "ConverbWhen"},
 		{Question, "Question"},
 		{MorphTag(-1), "MorphTag(-1)"},
 		{MorphTag(9999), "MorphTag(9999)"},
@@ -390,13 +412,17 @@ func TestMorphTagJSON(t *testing.T) {
 		Plural, Poss1Sg, Poss2Sg, Poss3Sg, Poss1Pl, Poss2Pl, Poss3Pl,
 		CaseGen, CaseDat, CaseAcc, CaseLoc, CaseAbl, CaseIns,
 		DerivAgent, DerivAbstract, DerivPriv, DerivPoss, DerivVerb,
+		DerivFellow, DerivPlace, DerivDim, DerivEquative, DerivRel,
+		DerivVerbAgent, DerivVerbRefl,
 		Copula,
 		VoicePass, VoiceReflex, VoiceRecip, VoiceCaus,
 		Negation,
 		TensePastDef, TensePastIndef, TensePresent, TenseFuture, TenseAorist, TensePastEvi,
 		MoodOblig, MoodCond, MoodImper,
-		Participle, ParticipleAdj, Gerund,
+		Participle, ParticipleAdj, Gerund, ParticiplePast, ParticipleFuture,
 		Pers1Sg, Pers2Sg, Pers1Pl, Pers2Pl, Pers3,
+		ConverbSeq, ConverbManner, ConverbWhile, ConverbWithout,
+		ConverbUpon, ConverbWhen,
 		Question,
 	}
 
@@ -504,8 +530,8 @@ func TestAnalysisString(t *testing.T) {
 // ---------------------------------------------------------------------------
 
 func TestSuffixTableCompleteness(t *testing.T) {
-	if len(suffixRules) != 44 {
-		t.Errorf("suffixRules has %d entries, want 44", len(suffixRules))
+	if len(suffixRules) != 57 {
+		t.Errorf("suffixRules has %d entries, want 57", len(suffixRules))
 	}
 
 	// Check all surfaces are lowercase
@@ -537,6 +563,8 @@ func TestSuffixTableCompleteness(t *testing.T) {
 		CaseAbl: true, CaseIns: true,
 		DerivAgent: true, DerivAbstract: true, DerivPriv: true,
 		DerivPoss: true, DerivVerb: true,
+		DerivFellow: true, DerivPlace: true, DerivDim: true,
+		DerivEquative: true, DerivRel: true, DerivVerbAgent: true, DerivVerbRefl: true,
 		Copula:  true,
 		VoicePass: true, VoiceReflex: true, VoiceRecip: true, VoiceCaus: true,
 		Negation: true,
@@ -544,7 +572,10 @@ func TestSuffixTableCompleteness(t *testing.T) {
 		TenseFuture: true, TenseAorist: true, TensePastEvi: true,
 		MoodOblig: true, MoodCond: true,
 		Participle: true, Gerund: true,
+		ParticiplePast: true, ParticipleFuture: true,
 		Pers1Sg: true, Pers2Sg: true, Pers1Pl: true, Pers2Pl: true, Pers3: true,
+		ConverbSeq: true, ConverbManner: true, ConverbWhile: true,
+		ConverbWithout: true, ConverbUpon: true, ConverbWhen: true,
 		Question: true,
 	}
 
@@ -893,6 +924,36 @@ func TestAnalyzeSuffixCategories(t *testing.T) {
 
 		// Verb: voice passive + evidential past
 		{"yazılıb", "yaz", []MorphTag{VoicePass, TensePastEvi}},
+
+		// Converbs: same -ıb surface as the evidential past
+		{"gəlib", "gəl", []MorphTag{ConverbSeq}},
+		{"gəlib", "gəl", []MorphTag{TensePastEvi}},
+		{"yazılıb", "yaz", []MorphTag{VoicePass, ConverbSeq}},
+		{"düşünərək", "düşün", []MorphTag{ConverbManner}},
+		{"gələndə", "gəl", []MorphTag{ConverbWhile}},
+		{"gəlmədən", "gəl", []MorphTag{ConverbWithout}},
+		{"yazınca", "yaz", []MorphTag{ConverbUpon}},
+		{"gəldikdə", "gəl", []MorphTag{ConverbWhen}},
+		{"oxuduqca", "oxu", []MorphTag{Gerund}},
+
+		// Relative participles before a possessive (k/q softened)
+		{"yazdığım", "yaz", []MorphTag{ParticiplePast, Poss1Sg}},
+		{"gördüyümüz", "gör", []MorphTag{ParticiplePast, Poss1Pl}},
+		{"yazdıqları", "yaz", []MorphTag{ParticiplePast, Poss3Pl}},
+		{"bildiyini", "bil", []MorphTag{ParticiplePast, Poss3Sg, CaseAcc}},
+		{"yazacağımız", "yaz", []MorphTag{ParticipleFuture, Poss1Pl}},
+
+		// Derivations, stacked on each other and on voice
+		{"yoldaşlıq", "yol", []MorphTag{DerivFellow, DerivAbstract}},
+		{"kitabxanaçı", "kitab", []MorphTag{DerivPlace, DerivAgent}},
+		{"Özbəkistan", "Özbək", []MorphTag{DerivPlace}},
+		{"evcik", "ev", []MorphTag{DerivDim}},
+		{"uşaqca", "uşaq", []MorphTag{DerivEquative}},
+		{"dinləyici", "dinləy", []MorphTag{DerivVerbAgent}},
+		{"evləndi", "ev", []MorphTag{DerivVerbRefl, TensePastDef}},
+		{"yaxşılaşdırılmış", "yaxşı", []MorphTag{DerivVerb, VoiceCaus, VoicePass, TensePastIndef}},
+		{"yazdırıldı", "yaz", []MorphTag{VoiceCaus, VoicePass, TensePastDef}},
+		{"görüşdürdü", "gör", []MorphTag{VoiceRecip, VoiceCaus, TensePastDef}},
 	}
 
 	for _, tt := range tests {
@@ -910,6 +971,137 @@ func TestAnalyzeSuffixCategories(t *testing.T) {
 	}
 }
 
+// TestAnalyzeDerivationFirst checks that the full derivation chain of a
+// word ranks first, even where the derived word is itself a dictionary
+// entry (evcik) or the verb stem is (yaxşılaşdır).
+func TestAnalyzeDerivationFirst(t *testing.T) {
+	tests := []struct {
+		word string
+		stem string
+		tags []MorphTag
+	}{
+		{"yaxşılaşdırılmış", "yaxşı", []MorphTag{DerivVerb, VoiceCaus, VoicePass, TensePastIndef}},
+		{"evcik", "ev", []MorphTag{DerivDim}},
+		{"pulcuq", "pul", []MorphTag{DerivDim}},
+		{"uşaqca", "uşaq", []MorphTag{DerivEquative}},
+		{"birləşdirildi", "bir", []MorphTag{DerivVerb, VoiceCaus, VoicePass, TensePastDef}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			got := Analyze(tt.word)[0]
+			tags := make([]MorphTag, len(got.Morphemes))
+			for i, m := range got.Morphemes {
+				tags[i] = m.Tag
+			}
+			if got.Stem != tt.stem || !slices.Equal(tags, tt.tags) {
+				t.Errorf("Analyze(%q)[0] = %v, want stem=%q tags=%v", tt.word, got, tt.stem, tt.tags)
+			}
+			if s := Stem(tt.word); s != tt.stem {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, s, tt.stem)
+			}
+		})
+	}
+}
+
+// TestDerivationFalseSplits checks common words whose endings look like a
+// derivation or the causative -t but whose base is not a fitting stem.
+func TestDerivationFalseSplits(t *testing.T) {
+	tests := []struct {
+		word string
+		bad  string // stem of the false split
+	}{
+		{"elementdir", "elemen"},
+		{"ekvivalentdir", "ekvivalen"},
+		{"masal", "ma"},
+		{"ulusal", "ulu"},
+		{"protestan", "prote"},
+		{"konstan", "kon"},
+		{"assistan", "ass"},
+		{"sonucu", "son"},
+		{"qurulan", "quru"},
+		{"metil", "me"},
+		{"tekstil", "teks"},
+		{"koca", "ko"},
+		{"olacam", "ola"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got == tt.bad {
+				t.Errorf("Stem(%q) = %q, a false split", tt.word, got)
+			}
+		})
+	}
+}
+
+// TestSubordinateForms checks that converbs and relative participles stem
+// to the verb root, that a bare relative participle parses after the
+// finite reading, and that only a post-consonant possessive follows one.
+func TestSubordinateForms(t *testing.T) {
+	stems := []struct {
+		word string
+		want string
+	}{
+		{"gələndə", "gəl"},
+		{"dəyişəndə", "dəyiş"},
+		{"getdikdə", "get"},
+		{"gəlmədən", "gəl"},
+		{"bilmədən", "bil"},
+		{"deyincə", "dey"},
+		{"düşünərək", "düşün"},
+		{"görüb", "gör"},
+		{"yazdığım", "yaz"},
+		{"bildiyimiz", "bil"},
+		{"yazacağımız", "yaz"},
+	}
+	for _, tt := range stems {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+
+	bare := []struct {
+		word   string
+		finite []MorphTag
+		part   MorphTag
+	}{
+		{"gələcək", []MorphTag{TenseFuture}, ParticipleFuture},
+		{"yazacaq", []MorphTag{TenseFuture}, ParticipleFuture},
+		{"yazdıq", []MorphTag{TensePastDef, Pers1Pl}, ParticiplePast},
+	}
+	for _, tt := range bare {
+		t.Run("bare "+tt.word, func(t *testing.T) {
+			results := Analyze(tt.word)
+			if a := results[0]; len(a.Morphemes) != len(tt.finite) || !containsTags(a.Morphemes, tt.finite) {
+				t.Errorf("Analyze(%q)[0] = %v, want tags %v", tt.word, results[0], tt.finite)
+			}
+			if !hasAnalysis(results, results[0].Stem, []MorphTag{tt.part}) {
+				t.Errorf("Analyze(%q) = %v, want a bare %v reading", tt.word, results, tt.part)
+			}
+		})
+	}
+
+	rejected := []struct {
+		word string
+		stem string
+		tags []MorphTag
+	}{
+		// Post-vowel possessive after a consonant-final participle.
+		{"gəldikmi", "gəl", []MorphTag{ParticiplePast, Poss1Sg, CaseAcc}},
+		// Converbs are word-final.
+		{"gəlibsən", "gəl", []MorphTag{ConverbSeq, Pers2Sg}},
+	}
+	for _, tt := range rejected {
+		t.Run("reject "+tt.word, func(t *testing.T) {
+			if results := Analyze(tt.word); hasAnalysis(results, tt.stem, tt.tags) {
+				t.Errorf("Analyze(%q) has unexpected analysis %s%v", tt.word, tt.stem, tt.tags)
+			}
+		})
+	}
+}
+
 // ---------------------------------------------------------------------------
 // Consonant assimilation (d/t alternation)
 // ---------------------------------------------------------------------------
@@ -1378,8 +1570,8 @@ func ExampleAnalyze() {
 	}
 	// Output:
 	// kitab[Plural:lar]
-	// kitabl[TenseAorist:ar]
 	// kitablar
+	// kitabl[TenseAorist:ar]
 }
 
 func ExampleStems() {
@@ -0,0 +1,157 @@
+// Inflection paradigm tables for Azerbaijani nouns and verbs.
+//
+// Paradigm expands a dictionary lemma into every cell of its table by
+// running Generate over a fixed grid of tag combinations. The dictionary
+// POS byte selects the grid: nouns and adjectives get the nominal
+// number × possessive × case table, verbs the tense × polarity × person
+// table, and uninflected parts of speech a single bare cell.
+package morph
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// ParadigmCell is one inflected form in a paradigm table.
+// Unmarked categories (singular, no possessive, nominative, positive
+// polarity, 3rd person singular) contribute no tag.
+type ParadigmCell struct {
+	Tags []MorphTag `json:"tags"` // Suffix tags in morphotactic order
+	Form string     `json:"form"` // Generated surface form
+}
+
+// ParadigmTable is the full inflection table of a lemma.
+type ParadigmTable struct {
+	Lemma string         `json:"lemma"` // Dictionary lemma (verbs without -maq/-mək)
+	POS   POS            `json:"pos"`   // Part of speech that selected the table
+	Cells []ParadigmCell `json:"cells"` // Cells in table order
+}
+
+// Form returns the surface form stored under the given tag combination,
+// or "" if the table has no such cell.
+func (p ParadigmTable) Form(tags ...MorphTag) string {
+	for _, c := range p.Cells {
+		if slices.Equal(c.Tags, tags) {
+			return c.Form
+		}
+	}
+	return ""
+}
+
+// Paradigm axes. Zero marks the unmarked member of each category.
+var (
+	paradigmNumbers    = []MorphTag{0, Plural}
+	paradigmPossessors = []MorphTag{0, Poss1Sg, Poss2Sg, Poss3Sg, Poss1Pl, Poss2Pl, Poss3Pl}
+	paradigmCases      = []MorphTag{0, CaseGen, CaseDat, CaseAcc, CaseLoc, CaseAbl, CaseIns}
+	paradigmTenses     = []MorphTag{
+		TensePresent, TensePastDef, TensePastIndef, TensePastEvi,
+		TenseFuture, TenseAorist, MoodOblig, MoodCond,
+	}
+	paradigmPolarities = []MorphTag{0, Negation}
+	paradigmPersons    = []MorphTag{Pers1Sg, Pers2Sg, 0, Pers1Pl, Pers2Pl, Pers3}
+)
+
+// Paradigm returns the complete inflection table for a dictionary lemma.
+// Nouns and adjectives yield number × possessive × case (98 cells);
+// verbs yield tense × polarity × person (96 cells) and may be given
+// either as the bare stem (gəl) or the infinitive (gəlmək). The
+// infinitive also selects the verb table for stems the dictionary lists
+// under another part of speech.
+// Pronouns yield their irregular case table (with plural for o and bu).
+// Adverbs and other uninflected lemmas yield a single bare cell.
+//
+// Returns a table with POSUnknown and no cells if lemma is not in the
+// dictionary.
+func Paradigm(lemma string) ParadigmTable {
+	return defaultAnalyzer.Paradigm(lemma)
+}
+
+// Paradigm is like the package-level Paradigm but selects the table by
+// the Analyzer's lexicon, so extra stems get a full table.
+func (an *Analyzer) Paradigm(lemma string) ParadigmTable {
+	if lemma == "" || len(lemma) > maxWordBytes {
+		return ParadigmTable{Lemma: lemma}
+	}
+	lemma = azcase.ComposeNFC(lemma)
+	low := azcase.ToLower(lemma)
+
+	// An infinitive selects the verb table even when the dictionary lists
+	// the bare stem under another POS (yazmaq vs the noun yaz "summer").
+	pos := an.LookupPOS(low)
+	if pos == POSUnknown {
+		if verb := stripInfinitive(low); verb != low && an.isKnownStem(verb) {
+			lemma, pos = string([]rune(lemma)[:utf8.RuneCountInString(verb)]), POSVerb
+		}
+	}
+
+	p := ParadigmTable{Lemma: lemma, POS: pos}
+	switch pos {
+	case POSNoun, POSAdj:
+		p.Cells = nounParadigm(lemma)
+	case POSVerb:
+		p.Cells = verbParadigm(lemma)
+	case POSPronoun:
+		p.Cells = pronounParadigm(lemma)
+	case POSAdv, POSOther:
+		p.Cells = []ParadigmCell{{Tags: []MorphTag{}, Form: lemma}}
+	}
+	return p
+}
+
+// nounParadigm generates the number × possessive × case grid.
+func nounParadigm(stem string) []ParadigmCell {
+	cells := make([]ParadigmCell, 0,
+		len(paradigmNumbers)*len(paradigmPossessors)*len(paradigmCases))
+	for _, num := range paradigmNumbers {
+		for _, poss := range paradigmPossessors {
+			for _, c := range paradigmCases {
+				cells = appendCell(cells, stem, num, poss, c)
+			}
+		}
+	}
+	return cells
+}
+
+// verbParadigm generates the tense × polarity × person grid.
+func verbParadigm(stem string) []ParadigmCell {
+	cells := make([]ParadigmCell, 0,
+		len(paradigmTenses)*len(paradigmPolarities)*len(paradigmPersons))
+	for _, tense := range paradigmTenses {
+		for _, pol := range paradigmPolarities {
+			for _, pers := range paradigmPersons {
+				cells = appendCell(cells, stem, pol, tense, pers)
+			}
+		}
+	}
+	return cells
+}
+
+// appendCell generates stem with the non-zero tags and appends the cell.
+// Combinations rejected by Generate are skipped.
+func appendCell(cells []ParadigmCell, stem string, tags ...MorphTag) []ParadigmCell {
+	marked := make([]MorphTag, 0, len(tags))
+	for _, t := range tags {
+		if t != 0 {
+			marked = append(marked, t)
+		}
+	}
+	form, err := Generate(stem, marked)
+	if err != nil {
+		return cells
+	}
+	return append(cells, ParadigmCell{Tags: marked, Form: form})
+}
+
+// stripInfinitive removes the infinitive suffix -maq/-mək from a verb
+// citation form, returning s unchanged if it has neither.
+func stripInfinitive(s string) string {
+	for _, suffix := range []string{"maq", "mək"} {
+		if base, ok := strings.CutSuffix(s, suffix); ok && base != "" {
+			return base
+		}
+	}
+	return s
+}
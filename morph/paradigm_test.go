@@ -0,0 +1,147 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestParadigmNoun(t *testing.T) {
+	p := Paradigm("kitab")
+	if p.POS != POSNoun {
+		t.Fatalf("Paradigm(kitab).POS = %v, want Noun", p.POS)
+	}
+	if len(p.Cells) != 98 {
+		t.Fatalf("Paradigm(kitab) has %d cells, want 98", len(p.Cells))
+	}
+
+	tests := []struct {
+		tags []MorphTag
+		want string
+	}{
+		{nil, "kitab"},
+		{[]MorphTag{CaseGen}, "kitabın"},
+		{[]MorphTag{CaseIns}, "kitabla"},
+		{[]MorphTag{Poss3Sg, CaseDat}, "kitabına"},
+		{[]MorphTag{Poss1Pl, CaseAbl}, "kitabımızdan"},
+		{[]MorphTag{Plural}, "kitablar"},
+		{[]MorphTag{Plural, Poss1Pl, CaseAbl}, "kitablarımızdan"},
+		{[]MorphTag{Plural, Poss3Pl}, "kitabları"},
+		{[]MorphTag{Plural, Poss3Pl, CaseLoc}, "kitablarında"},
+	}
+	for _, tt := range tests {
+		if got := p.Form(tt.tags...); got != tt.want {
+			t.Errorf("Paradigm(kitab).Form(%v) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
+
+func TestParadigmVerb(t *testing.T) {
+	tests := []struct {
+		lemma string
+		tags  []MorphTag
+		want  string
+	}{
+		{"gəl", []MorphTag{TensePresent, Pers1Sg}, "gəlirəm"},
+		{"gəl", []MorphTag{TensePresent}, "gəlir"},
+		{"gəl", []MorphTag{Negation, TensePresent, Pers3}, "gəlmirlər"},
+		{"gəl", []MorphTag{TensePastDef, Pers1Pl}, "gəldik"},
+		{"gəl", []MorphTag{Negation, TensePastDef, Pers2Pl}, "gəlmədiniz"},
+		{"gəl", []MorphTag{TenseFuture, Pers1Sg}, "gələcəyəm"},
+		{"gəl", []MorphTag{Negation, TenseAorist, Pers1Sg}, "gəlmərəm"},
+		{"gəl", []MorphTag{MoodCond, Pers2Sg}, "gəlsən"},
+		{"gəlmək", []MorphTag{TensePastIndef, Pers1Sg}, "gəlmişəm"},
+		// yaz is listed as a noun; the infinitive selects the verb table.
+		{"yazmaq", []MorphTag{TensePastDef, Pers1Pl}, "yazdıq"},
+		// get and et voice their t before a vowel.
+		{"getmək", []MorphTag{TensePresent, Pers1Sg}, "gedirəm"},
+		{"getmək", []MorphTag{TenseFuture, Pers3}, "gedəcəklər"},
+		{"getmək", []MorphTag{TensePastDef, Pers1Sg}, "getdim"},
+		{"getmək", []MorphTag{Negation, TensePresent}, "getmir"},
+		{"etmək", []MorphTag{TensePresent, Pers2Sg}, "edirsən"},
+		{"etmək", []MorphTag{TenseFuture, Pers1Sg}, "edəcəyəm"},
+		{"etmək", []MorphTag{TensePastIndef, Pers1Pl}, "etmişik"},
+		{"etmək", []MorphTag{MoodCond, Pers1Sg}, "etsəm"},
+	}
+	for _, tt := range tests {
+		p := Paradigm(tt.lemma)
+		if p.POS != POSVerb {
+			t.Fatalf("Paradigm(%q).POS = %v, want Verb", tt.lemma, p.POS)
+		}
+		if len(p.Cells) != 96 {
+			t.Fatalf("Paradigm(%q) has %d cells, want 96", tt.lemma, len(p.Cells))
+		}
+		if got := p.Form(tt.tags...); got != tt.want {
+			t.Errorf("Paradigm(%q).Form(%v) = %q, want %q", tt.lemma, tt.tags, got, tt.want)
+		}
+	}
+}
+
+func TestParadigmPOSSelection(t *testing.T) {
+	tests := []struct {
+		lemma string
+		lem   string
+		pos   POS
+		cells int
+	}{
+		{"kitab", "kitab", POSNoun, 98},
+		{"gözəl", "gözəl", POSAdj, 98},
+		{"gəl", "gəl", POSVerb, 96},
+		{"gəlmək", "gəl", POSVerb, 96},
+		{"çox", "çox", POSAdv, 1},
+		{"mən", "mən", POSPronoun, 7},
+		{"bu", "bu", POSPronoun, 14},
+		{"xyznotfound", "xyznotfound", POSUnknown, 0},
+		{"", "", POSUnknown, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.lemma, func(t *testing.T) {
+			p := Paradigm(tt.lemma)
+			if p.Lemma != tt.lem || p.POS != tt.pos || len(p.Cells) != tt.cells {
+				t.Errorf("Paradigm(%q) = {%q %v %d cells}, want {%q %v %d cells}",
+					tt.lemma, p.Lemma, p.POS, len(p.Cells), tt.lem, tt.pos, tt.cells)
+			}
+		})
+	}
+}
+
+func TestParadigmCellsUnique(t *testing.T) {
+	for _, lemma := range []string{"kitab", "gəl", "o"} {
+		seen := make(map[string]bool)
+		for _, c := range Paradigm(lemma).Cells {
+			k := tagsKey(toMorphemes(c.Tags))
+			if seen[k] {
+				t.Errorf("Paradigm(%q) has duplicate cell %v", lemma, c.Tags)
+			}
+			seen[k] = true
+		}
+	}
+}
+
+func TestParadigmJSON(t *testing.T) {
+	data, err := json.Marshal(Paradigm("çox"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"lemma":"çox","pos":"Adv","cells":[{"tags":[],"form":"çox"}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal(Paradigm(çox)) = %s, want %s", data, want)
+	}
+}
+
+func BenchmarkParadigm(b *testing.B) {
+	for b.Loop() {
+		Paradigm("kitab")
+	}
+}
+
+func ExampleParadigm() {
+	p := Paradigm("gəlmək")
+	fmt.Println(p.Lemma, p.POS)
+	fmt.Println(p.Form(TensePresent, Pers1Sg))
+	fmt.Println(p.Form(Negation, TensePastDef, Pers3))
+	// Output:
+	// gəl Verb
+	// gəlirəm
+	// gəlmədilər
+}
@@ -39,8 +39,15 @@ var voicelessCons = map[rune]bool{
 }
 
 // isVowel reports whether r is an Azerbaijani vowel (any case).
+// It is on the walker's hot path, so it switches instead of reading the
+// vowel maps.
 func isVowel(r rune) bool {
-	return backVowels[r] || frontVowels[r]
+	switch r {
+	case 'a', 'A', '\u0131', 'I', 'o', 'O', 'u', 'U',
+		'e', 'E', '\u0259', '\u018F', 'i', '\u0130', '\u00F6', '\u00D6', '\u00FC', '\u00DC':
+		return true
+	}
+	return false
 }
 
 // isBackVowel reports whether r is an Azerbaijani back vowel.
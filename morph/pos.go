@@ -0,0 +1,93 @@
+package morph
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// POS is the part of speech of a dictionary lemma.
+type POS int
+
+const (
+	POSUnknown POS = iota // zero value, lemma not in the dictionary
+	POSNoun               // noun, proper name, numeral, determiner (dict.txt N)
+	POSVerb               // verb (dict.txt V)
+	POSAdj                // adjective (dict.txt A)
+	POSAdv                // adverb, interjection, conjunction, postposition, particle (dict.txt D)
+	POSOther              // any other Wiktionary category (dict.txt X)
+	POSPronoun            // personal or demonstrative pronoun (irregular table)
+)
+
+// posNames maps POS values to their string names.
+var posNames = [...]string{
+	POSUnknown: "Unknown",
+	POSNoun:    "Noun",
+	POSVerb:    "Verb",
+	POSAdj:     "Adj",
+	POSAdv:     "Adv",
+	POSOther:   "Other",
+	POSPronoun: "Pronoun",
+}
+
+// posFromName maps string names back to POS values.
+var posFromName = map[string]POS{
+	"Unknown": POSUnknown,
+	"Noun":    POSNoun,
+	"Verb":    POSVerb,
+	"Adj":     POSAdj,
+	"Adv":     POSAdv,
+	"Other":   POSOther,
+	"Pronoun": POSPronoun,
+}
+
+// String returns the name of the part of speech.
+func (p POS) String() string {
+	if int(p) >= 0 && int(p) < len(posNames) {
+		return posNames[p]
+	}
+	return fmt.Sprintf("POS(%d)", int(p))
+}
+
+// MarshalJSON encodes the part of speech as a JSON string (e.g. "Noun").
+func (p POS) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+// UnmarshalJSON decodes a JSON string (e.g. "Noun") into a POS.
+func (p *POS) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	pos, ok := posFromName[s]
+	if !ok {
+		return fmt.Errorf("morph: unknown part of speech: %q", s)
+	}
+	*p = pos
+	return nil
+}
+
+// posFromByte converts a dict.txt POS byte to a POS value.
+func posFromByte(b byte) POS {
+	switch b {
+	case 'N':
+		return POSNoun
+	case 'V':
+		return POSVerb
+	case 'A':
+		return POSAdj
+	case 'D':
+		return POSAdv
+	case 'X':
+		return POSOther
+	}
+	return POSUnknown
+}
+
+// LookupPOS returns the part of speech of a known dictionary stem,
+// or POSUnknown if s is not in the dictionary. Pronouns with irregular
+// paradigms (mən, o, bu, ...) are POSPronoun.
+// Expects lowercase Azerbaijani Latin input.
+func LookupPOS(s string) POS {
+	return defaultAnalyzer.LookupPOS(s)
+}
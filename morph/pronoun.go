@@ -0,0 +1,159 @@
+// Irregular pronoun paradigms for Azerbaijani morphological analysis.
+//
+// Personal and demonstrative pronouns decline with forms the suffix FSM
+// cannot produce: the genitive -im of mən and biz, pronominal -n- after
+// o and bu (onun, ona, bunlar), and instrumentals built on the genitive
+// (mənimlə, onunla). These forms are listed in a fixed table that is
+// consulted before the FSM by Analyze, Stem and Generate.
+package morph
+
+import (
+	"slices"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// pronounCaseTags lists the case of each entry in pronounDecl.cases.
+var pronounCaseTags = [...]MorphTag{CaseGen, CaseAcc, CaseDat, CaseLoc, CaseAbl, CaseIns}
+
+// pronounDecl is the declension of one pronoun: the case suffixes that
+// follow the lemma directly and, for o and bu, the plural suffix with
+// the case suffixes that follow it.
+type pronounDecl struct {
+	lemma       string
+	cases       [len(pronounCaseTags)]string
+	plural      string
+	pluralCases [len(pronounCaseTags)]string
+}
+
+// pronounDecls is the irregular pronoun table.
+var pronounDecls = []pronounDecl{
+	{lemma: "mən", cases: [...]string{"im", "i", "ə", "də", "dən", "imlə"}},
+	{lemma: "sən", cases: [...]string{"in", "i", "ə", "də", "dən", "inlə"}},
+	{lemma: "biz", cases: [...]string{"im", "i", "ə", "də", "dən", "imlə"}},
+	{lemma: "siz", cases: [...]string{"in", "i", "ə", "də", "dən", "inlə"}},
+	{
+		lemma:       "o",
+		cases:       [...]string{"nun", "nu", "na", "nda", "ndan", "nunla"},
+		plural:      "nlar",
+		pluralCases: [...]string{"ın", "ı", "a", "da", "dan", "la"},
+	},
+	{
+		lemma:       "bu",
+		cases:       [...]string{"nun", "nu", "na", "nda", "ndan", "nunla"},
+		plural:      "nlar",
+		pluralCases: [...]string{"ın", "ı", "a", "da", "dan", "la"},
+	},
+}
+
+// pronounForm is one inflected form of a pronoun.
+type pronounForm struct {
+	lemma     string
+	morphemes []Morpheme // lowercase surfaces, in order
+}
+
+// Irregular pronoun forms, populated by init().
+var (
+	pronounList  []pronounForm          // all forms in table order
+	pronounForms map[string]pronounForm // lowercase word -> form
+)
+
+func init() {
+	for _, d := range pronounDecls {
+		add := func(ms ...Morpheme) {
+			pronounList = append(pronounList, pronounForm{lemma: d.lemma, morphemes: ms})
+		}
+		add()
+		for i, tag := range pronounCaseTags {
+			add(Morpheme{Surface: d.cases[i], Tag: tag})
+		}
+		if d.plural == "" {
+			continue
+		}
+		pl := Morpheme{Surface: d.plural, Tag: Plural}
+		add(pl)
+		for i, tag := range pronounCaseTags {
+			add(pl, Morpheme{Surface: d.pluralCases[i], Tag: tag})
+		}
+	}
+	pronounForms = make(map[string]pronounForm, len(pronounList))
+	for _, f := range pronounList {
+		pronounForms[f.word()] = f
+	}
+}
+
+// word returns the surface form of f.
+func (f pronounForm) word() string {
+	w := f.lemma
+	for _, m := range f.morphemes {
+		w += m.Surface
+	}
+	return w
+}
+
+// tags returns the morpheme tags of f.
+func (f pronounForm) tags() []MorphTag {
+	tags := make([]MorphTag, len(f.morphemes))
+	for i, m := range f.morphemes {
+		tags[i] = m.Tag
+	}
+	return tags
+}
+
+// isPronoun reports whether s is a pronoun lemma of the irregular table.
+// Expects lowercase input.
+func isPronoun(s string) bool {
+	f, ok := pronounForms[s]
+	return ok && len(f.morphemes) == 0
+}
+
+// pronounAnalysis returns the analysis of word from the irregular pronoun
+// table, with stem and morpheme surfaces cased like word. Returns false if
+// word is not a pronoun form or its lemma is blocked by the Analyzer.
+func (an *Analyzer) pronounAnalysis(word string) (Analysis, bool) {
+	f, ok := pronounForms[azcase.ToLower(word)]
+	if !ok || an.isBlocked(f.lemma) {
+		return Analysis{}, false
+	}
+	orig := []rune(word)
+	if len(orig) != len([]rune(f.word())) {
+		return Analysis{}, false
+	}
+	n := len([]rune(f.lemma))
+	a := Analysis{Stem: string(orig[:n])}
+	for _, m := range f.morphemes {
+		l := len([]rune(m.Surface))
+		a.Morphemes = append(a.Morphemes, Morpheme{Surface: string(orig[n : n+l]), Tag: m.Tag})
+		n += l
+	}
+	return a, true
+}
+
+// generatePronoun returns the irregular form of the pronoun stem with the
+// given tags, cased like stem. Returns false if stem is not a pronoun lemma
+// or the table has no such form.
+func generatePronoun(stem string, tags []MorphTag) (string, bool) {
+	low := azcase.ToLower(stem)
+	if !isPronoun(low) {
+		return "", false
+	}
+	for _, f := range pronounList {
+		if f.lemma == low && slices.Equal(f.tags(), tags) {
+			return stem + f.word()[len(low):], true
+		}
+	}
+	return "", false
+}
+
+// pronounParadigm returns the cells of the irregular table for a pronoun
+// lemma, cased like lemma.
+func pronounParadigm(lemma string) []ParadigmCell {
+	low := azcase.ToLower(lemma)
+	var cells []ParadigmCell
+	for _, f := range pronounList {
+		if f.lemma == low {
+			cells = append(cells, ParadigmCell{Tags: f.tags(), Form: lemma + f.word()[len(low):]})
+		}
+	}
+	return cells
+}
@@ -0,0 +1,115 @@
+package morph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAnalyzePronoun(t *testing.T) {
+	tests := []struct {
+		word string
+		want string // first analysis
+	}{
+		// -- Personal pronouns --
+		{"mən", "mən"},
+		{"mənim", "mən[CaseGen:im]"},
+		{"məni", "mən[CaseAcc:i]"},
+		{"mənə", "mən[CaseDat:ə]"},
+		{"məndən", "mən[CaseAbl:dən]"},
+		{"mənimlə", "mən[CaseIns:imlə]"},
+		{"sənin", "sən[CaseGen:in]"},
+		{"bizim", "biz[CaseGen:im]"},
+		{"sizə", "siz[CaseDat:ə]"},
+
+		// -- n-insertion after o and bu --
+		{"onun", "o[CaseGen:nun]"},
+		{"ona", "o[CaseDat:na]"},
+		{"onda", "o[CaseLoc:nda]"},
+		{"onunla", "o[CaseIns:nunla]"},
+		{"bunu", "bu[CaseAcc:nu]"},
+
+		// -- Plural --
+		{"onlar", "o[Plural:nlar]"},
+		{"onların", "o[Plural:nlar|CaseGen:ın]"},
+		{"bunlar", "bu[Plural:nlar]"},
+		{"bunlardan", "bu[Plural:nlar|CaseAbl:dan]"},
+
+		// -- Case preservation --
+		{"Mənim", "Mən[CaseGen:im]"},
+		{"ONA", "O[CaseDat:NA]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			results := Analyze(tt.word)
+			if got := results[0].String(); got != tt.want {
+				t.Errorf("Analyze(%q)[0] = %s, want %s", tt.word, got, tt.want)
+			}
+			for _, a := range results {
+				verifyInvariants(t, tt.word, a)
+			}
+		})
+	}
+}
+
+func TestStemPronoun(t *testing.T) {
+	for _, tt := range []struct{ word, want string }{
+		{"mənim", "mən"},
+		{"onun", "o"},
+		{"onlar", "o"},
+		{"bunlar", "bu"},
+		{"Bizə", "Biz"},
+	} {
+		if got := Stem(tt.word); got != tt.want {
+			t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestLemmatizePronoun(t *testing.T) {
+	for _, w := range []string{"məni", "ona", "bunlara", "sizinlə"} {
+		if l := Lemmatize(w); l.POS != POSPronoun {
+			t.Errorf("Lemmatize(%q).POS = %v, want Pronoun", w, l.POS)
+		}
+	}
+}
+
+func TestGeneratePronoun(t *testing.T) {
+	tests := []struct {
+		stem string
+		tags []MorphTag
+		want string
+	}{
+		{"mən", []MorphTag{CaseGen}, "mənim"},
+		{"o", []MorphTag{CaseDat}, "ona"},
+		{"bu", []MorphTag{Plural, CaseAbl}, "bunlardan"},
+		{"Siz", []MorphTag{CaseIns}, "Sizinlə"},
+	}
+	for _, tt := range tests {
+		got, err := Generate(tt.stem, tt.tags)
+		if err != nil || got != tt.want {
+			t.Errorf("Generate(%q, %v) = %q, %v, want %q", tt.stem, tt.tags, got, err, tt.want)
+		}
+	}
+}
+
+func TestPronounBlocked(t *testing.T) {
+	an := NewAnalyzer(Options{Blocked: []string{"o"}})
+	if got := an.Analyze("ona")[0].String(); got == "o[CaseDat:na]" {
+		t.Errorf("Analyze(ona) with o blocked = %s", got)
+	}
+	if pos := an.LookupPOS("o"); pos != POSUnknown {
+		t.Errorf("LookupPOS(o) with o blocked = %v, want Unknown", pos)
+	}
+}
+
+func ExampleParadigm_pronoun() {
+	for _, c := range Paradigm("o").Cells[:4] {
+		fmt.Println(c.Tags, c.Form)
+	}
+	// Output:
+	// [] o
+	// [CaseGen] onun
+	// [CaseAcc] onu
+	// [CaseDat] ona
+}
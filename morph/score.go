@@ -0,0 +1,151 @@
+// Confidence scoring for Azerbaijani morphological analysis.
+//
+// rank gives every candidate analysis of a word a log-linear score built
+// from dictionary membership of the stem, the corpus frequency of the stem
+// as a word form (spell_freq.txt), and the plausibility of the suffix
+// chain. The analysis Stem selects gets a bonus and is always scored
+// first. Scores are normalized with a softmax over the word's analyses,
+// so they lie in [0, 1] and sum to 1 up to rounding.
+package morph
+
+import (
+	"bytes"
+	"math"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/data"
+)
+
+// Score weights for a single analysis.
+const (
+	knownStemWeight   = 2.0  // stem is a dictionary, lexicon or pronoun stem
+	stemFreqWeight    = 2.0  // times log-frequency of the stem, scaled to [0, 1]
+	stemPickWeight    = 5.0  // stem is the one Stem selects
+	stemPickMargin    = 1.0  // minimum lead of the selected analysis over the others
+	morphemePenalty   = 0.25 // per suffix in the chain
+	shortSuffixWeight = 0.5  // per single-rune suffix (-m, -n, -t), the likeliest false splits
+	posClashPenalty   = 0.5  // verbal suffix on a stem the dictionary lists as a nominal
+)
+
+// scoreTemperature flattens the softmax, so that alternatives to the
+// selected analysis keep a usable share of the confidence mass.
+const scoreTemperature = 2.0
+
+// scorePrecision rounds scores to 4 decimal places, so that they are
+// stable in JSON output across platforms.
+const scorePrecision = 1e4
+
+// Word frequencies from spell_freq.txt, loaded on first use by
+// loadWordFreq so that importers which never rank pay nothing.
+var (
+	wordFreqOnce sync.Once
+	wordFreq     map[string]int64 // lowercase word form -> corpus frequency
+	logMaxFreq   float64          // log1p of the highest frequency
+)
+
+// loadWordFreq parses data.SpellFreq into wordFreq and logMaxFreq.
+func loadWordFreq() {
+	// Each line is <word> <frequency>\n.
+	lines := bytes.Split(data.SpellFreq, []byte("\n"))
+	wordFreq = make(map[string]int64, len(lines))
+	var maxFreq int64
+	for _, line := range lines {
+		sp := bytes.LastIndexByte(line, ' ')
+		if sp <= 0 {
+			continue
+		}
+		freq, err := strconv.ParseInt(string(line[sp+1:]), 10, 64)
+		if err != nil || freq <= 0 {
+			continue
+		}
+		wordFreq[string(line[:sp])] = freq
+		maxFreq = max(maxFreq, freq)
+	}
+	logMaxFreq = math.Log1p(float64(maxFreq))
+}
+
+// stemFreq returns the corpus frequency of s as a word form scaled
+// logarithmically to [0, 1]. Expects lowercase input.
+func stemFreq(s string) float64 {
+	wordFreqOnce.Do(loadWordFreq)
+	f, ok := wordFreq[s]
+	if !ok || logMaxFreq == 0 {
+		return 0
+	}
+	return math.Log1p(float64(f)) / logMaxFreq
+}
+
+// rank scores the candidate analyses of a word, given the stem Stem
+// selects, and sorts them by descending score. Ties keep the candidate
+// order.
+func (an *Analyzer) rank(results []Analysis, stem string) []Analysis {
+	if len(results) == 1 {
+		results[0].Score = 1
+		return results
+	}
+	pick := an.stemIndex(results, stem)
+
+	type scored struct {
+		a   Analysis
+		raw float64
+	}
+	ss := make([]scored, len(results))
+	top := math.Inf(-1)
+	for i, a := range results {
+		ss[i] = scored{a, an.rawScore(a)}
+		if i != pick {
+			top = max(top, ss[i].raw)
+		}
+	}
+	if pick >= 0 {
+		ss[pick].raw = max(ss[pick].raw+stemPickWeight, top+stemPickMargin)
+		top = ss[pick].raw
+	}
+
+	// Sort on the raw scores, which rounding could turn into ties.
+	slices.SortStableFunc(ss, func(a, b scored) int {
+		switch {
+		case a.raw > b.raw:
+			return -1
+		case a.raw < b.raw:
+			return 1
+		}
+		return 0
+	})
+	var sum float64
+	for i := range ss {
+		ss[i].raw = math.Exp((ss[i].raw - top) / scoreTemperature)
+		sum += ss[i].raw
+	}
+	for i, s := range ss {
+		results[i] = s.a
+		results[i].Score = math.Round(s.raw/sum*scorePrecision) / scorePrecision
+	}
+	return results
+}
+
+// rawScore returns the unnormalized log-linear score of a.
+func (an *Analyzer) rawScore(a Analysis) float64 {
+	low := azcase.ToLower(a.Stem)
+	var s float64
+	if an.isKnownStem(low) {
+		s += knownStemWeight
+	}
+	s += stemFreqWeight * stemFreq(low)
+	s -= morphemePenalty * float64(len(a.Morphemes))
+	for _, m := range a.Morphemes {
+		if len([]rune(m.Surface)) == 1 {
+			s -= shortSuffixWeight
+		}
+	}
+	if isVerbal(a) {
+		switch an.LookupPOS(low) {
+		case POSNoun, POSAdj, POSPronoun:
+			s -= posClashPenalty
+		}
+	}
+	return s
+}
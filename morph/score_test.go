@@ -0,0 +1,85 @@
+package morph
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestAnalyzeScores(t *testing.T) {
+	words := []string{"kitablar", "gəlmişdir", "alma", "oxuyursan", "mənim", "qap-qara", "ağzım", "xyzabc", "kitab"}
+	for _, w := range words {
+		t.Run(w, func(t *testing.T) {
+			results := Analyze(w)
+			var sum float64
+			for i, a := range results {
+				if a.Score < 0 || a.Score > 1 {
+					t.Errorf("Analyze(%q)[%d] = %v score %v, want in [0, 1]", w, i, a, a.Score)
+				}
+				if i > 0 && a.Score > results[i-1].Score {
+					t.Errorf("Analyze(%q) not sorted: %v %v after %v %v", w, a, a.Score, results[i-1], results[i-1].Score)
+				}
+				sum += a.Score
+			}
+			if math.Abs(sum-1) > 1e-3 {
+				t.Errorf("Analyze(%q) scores sum to %v, want 1", w, sum)
+			}
+		})
+	}
+}
+
+func TestAnalyzeFirstMatchesStem(t *testing.T) {
+	words := []string{"kitablarımızdan", "evlərdə", "gəlmişdir", "oxuyursan", "alma", "gəlmədi", "ağzım", "onlar", "kitab-mitablar"}
+	for _, w := range words {
+		stem := Stem(w)
+		a := Analyze(w)[0]
+		if a.Stem != stem && defaultAnalyzer.tryRestoreVowelDrop(a.Stem) != stem {
+			t.Errorf("Analyze(%q)[0] = %v, want stem %q", w, a, stem)
+		}
+	}
+}
+
+func TestAnalyzeStemMatchesStem(t *testing.T) {
+	words := []string{"", "kitablarımızdan", "ağzım", "mənim", "kitab-mitablar", "Bakı'nın", "китаблар", string(make([]byte, maxWordBytes+1))}
+	for _, w := range words {
+		results, stem := defaultAnalyzer.analyzeStem(w)
+		if want := Stem(w); stem != want {
+			t.Errorf("analyzeStem(%.20q) stem = %q, want %q", w, stem, want)
+		}
+		if fmt.Sprint(results) != fmt.Sprint(Analyze(w)) {
+			t.Errorf("analyzeStem(%.20q) = %v, want %v", w, results, Analyze(w))
+		}
+	}
+}
+
+func TestAnalyzeScoreSingle(t *testing.T) {
+	for _, w := range []string{"a", string(make([]byte, maxWordBytes+1))} {
+		results := Analyze(w)
+		if len(results) != 1 || results[0].Score != 1 {
+			t.Errorf("Analyze(%.10q) = %v, want one analysis with score 1", w, results)
+		}
+	}
+}
+
+func TestStemFreq(t *testing.T) {
+	if f := stemFreq("və"); f <= 0 || f > 1 {
+		t.Errorf("stemFreq(və) = %v, want in (0, 1]", f)
+	}
+	if f := stemFreq("xyznotfound"); f != 0 {
+		t.Errorf("stemFreq(xyznotfound) = %v, want 0", f)
+	}
+	if stemFreq("kitab") <= stemFreq("kitabl") {
+		t.Error("stemFreq(kitab) should exceed stemFreq(kitabl)")
+	}
+}
+
+func ExampleAnalyze_threshold() {
+	for _, a := range Analyze("kitablar") {
+		if a.Score < 0.5 {
+			break
+		}
+		fmt.Println(a, a.Score)
+	}
+	// Output:
+	// kitab[Plural:lar] 0.9596
+}
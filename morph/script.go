@@ -0,0 +1,143 @@
+// Cyrillic-script input for Azerbaijani morphological analysis.
+//
+// Analyze, Stem, StemWith and Lemmatize accept words in the Soviet-era
+// Azerbaijani Cyrillic alphabet. The word is transliterated with
+// translit.CyrillicToLatin, analysed in Latin, and every stem and morpheme
+// surface of the result is mapped back onto the input runes, so that the
+// output keeps the original casing and letters (ь, ъ, the г/ҝ spelling).
+// Runes that the analysis changed (a k/q restored from y/ğ, a vowel
+// restored by vowel-drop repair, an infinitive suffix) are transliterated
+// with translit.LatinToCyrillic in the case of the input rune. A word with
+// letters outside that alphabet (Russian э, я) is analysed unchanged.
+package morph
+
+import (
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+	"github.com/az-ai-labs/az-lang-nlp/translit"
+)
+
+// scriptMap aligns a Cyrillic word with its Latin transliteration.
+type scriptMap struct {
+	latin  string   // transliterated word
+	lat    []rune   // runes of latin
+	groups [][]rune // input runes behind each rune of lat
+}
+
+// newScriptMap returns the alignment of word with its Latin
+// transliteration, or false if word contains no Cyrillic letter. It also
+// returns false if the transliteration keeps Cyrillic letters (Russian э,
+// я), so that such a word is analysed as is and the Latin word passed
+// back to the analysis never takes the Cyrillic path again.
+func newScriptMap(word string) (*scriptMap, bool) {
+	if !hasCyrillic(word) {
+		return nil, false
+	}
+	m := &scriptMap{latin: translit.CyrillicToLatin(word)}
+	if hasCyrillic(m.latin) {
+		return nil, false
+	}
+	m.lat = []rune(m.latin)
+
+	// Transliteration is rune for rune, except that the soft and hard
+	// signs are dropped; they stay attached to the preceding letter.
+	var lead []rune
+	for _, r := range word {
+		switch {
+		case isCyrillicSign(r) && len(m.groups) > 0:
+			m.groups[len(m.groups)-1] = append(m.groups[len(m.groups)-1], r)
+		case isCyrillicSign(r):
+			lead = append(lead, r)
+		default:
+			m.groups = append(m.groups, append(lead, r))
+			lead = nil
+		}
+	}
+	if len(m.groups) != len(m.lat) {
+		m.groups = nil // unexpected transliteration: map back rune by rune
+	}
+	return m, true
+}
+
+// hasCyrillic reports whether s contains a rune of the Cyrillic block.
+func hasCyrillic(s string) bool {
+	for _, r := range s {
+		if r >= '\u0400' && r <= '\u04FF' {
+			return true
+		}
+	}
+	return false
+}
+
+// isCyrillicSign reports whether r is the soft or hard sign.
+func isCyrillicSign(r rune) bool {
+	switch r {
+	case 'Ь', 'ь', 'Ъ', 'ъ':
+		return true
+	}
+	return false
+}
+
+// piece maps the Latin string s, found at rune offset off of the
+// transliterated word, back to the input script. Runes of s that agree
+// with the word are taken from the input; the others are transliterated.
+func (m *scriptMap) piece(s string, off int) string {
+	var out []rune
+	for i, r := range []rune(s) {
+		p := off + i
+		inWord := m.groups != nil && p >= 0 && p < len(m.lat)
+		if inWord && m.lat[p] == r {
+			out = append(out, m.groups[p]...)
+			continue
+		}
+		// A restored rune takes the case of the input rune it replaces.
+		if inWord && m.lat[p] != azcase.Lower(m.lat[p]) {
+			r = azcase.Upper(r)
+		}
+		out = append(out, []rune(translit.LatinToCyrillic(string(r)))...)
+	}
+	return string(out)
+}
+
+// analysis maps a Latin analysis starting at rune offset off back to the
+// input script. It returns the mapped analysis and the offset after it.
+func (m *scriptMap) analysis(a Analysis, off int) (Analysis, int) {
+	out := a
+	out.Stem = m.piece(a.Stem, off)
+	if a.Compound != nil {
+		c := *a.Compound
+		c.Parts = make([]Analysis, len(a.Compound.Parts))
+		p := off
+		for i, part := range a.Compound.Parts {
+			c.Parts[i], p = m.analysis(part, p)
+			if p < len(m.lat) && m.lat[p] == '-' {
+				p++
+			}
+		}
+		out.Compound = &c
+	}
+	off += len([]rune(a.Stem))
+	if a.Morphemes != nil {
+		out.Morphemes = make([]Morpheme, len(a.Morphemes))
+		for i, mo := range a.Morphemes {
+			out.Morphemes[i] = Morpheme{Surface: m.piece(mo.Surface, off), Tag: mo.Tag}
+			off += len([]rune(mo.Surface))
+		}
+	}
+	return out, off
+}
+
+// analyses maps Latin analyses of the whole word back to the input script.
+func (m *scriptMap) analyses(results []Analysis) []Analysis {
+	out := make([]Analysis, len(results))
+	for i, a := range results {
+		out[i], _ = m.analysis(a, 0)
+	}
+	return out
+}
+
+// lemma maps a Latin lemma of the whole word back to the input script.
+func (m *scriptMap) lemma(l Lemma) Lemma {
+	l.Form = m.piece(l.Form, 0)
+	l.Analysis, _ = m.analysis(l.Analysis, 0)
+	return l
+}
@@ -0,0 +1,155 @@
+package morph
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/az-ai-labs/az-lang-nlp/translit"
+)
+
+func TestAnalyzeCyrillic(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"китабларымыздан", "китаб[Plural:лар|Poss1Pl:ымыз|CaseAbl:дан]"},
+		{"Китаблар", "Китаб[Plural:лар]"},
+		{"ҝәлмәдим", "ҝәл[Negation:мә|TensePastDef:ди|Pers1Sg:м]"},
+		{"онлара", "о[Plural:нлар|CaseDat:а]"},
+		{"ҝүньләр", "ҝүнь[Plural:ләр]"},
+
+		// -- Restored k/q is transliterated in the case of the input --
+		{"ушағы", "ушаг[CaseAcc:ы]"},
+		{"УШАҒЫ", "УШАГ[CaseAcc:Ы]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Analyze(tt.word)[0].String(); got != tt.want {
+				t.Errorf("Analyze(%q)[0] = %s, want %s", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeCyrillicMatchesLatin(t *testing.T) {
+	for _, w := range []string{"китабларымыздан", "евләрдә", "ҝәлмишдир", "мәнимлә", "гапгара", "Бакыја"} {
+		lat := Analyze(translit.CyrillicToLatin(w))
+		cyr := Analyze(w)
+		if len(lat) != len(cyr) {
+			t.Fatalf("Analyze(%q) returned %d analyses, Latin %d", w, len(cyr), len(lat))
+		}
+		for i := range cyr {
+			if cyr[i].Score != lat[i].Score || tagsKey(cyr[i].Morphemes) != tagsKey(lat[i].Morphemes) {
+				t.Errorf("Analyze(%q)[%d] = %v, Latin %v", w, i, cyr[i], lat[i])
+			}
+		}
+	}
+}
+
+func TestStemCyrillic(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"китабларымыздан", "китаб"},
+		{"КИТАБЛАР", "КИТАБ"},
+		{"ағзым", "ағыз"},
+		{"китаб-митаблар", "китаб-митаб"},
+		{"Бакы'ја", "Бакы"},
+		{"гапгара", "гапгара"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLemmatizeCyrillic(t *testing.T) {
+	tests := []struct {
+		word string
+		form string
+		pos  POS
+	}{
+		{"јаздым", "јазмаг", POSVerb},
+		{"ҝәлирләр", "ҝәлмәк", POSVerb},
+		{"китабларымыздан", "китаб", POSNoun},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			l := Lemmatize(tt.word)
+			if l.Form != tt.form || l.POS != tt.pos {
+				t.Errorf("Lemmatize(%q) = %q %v, want %q %v", tt.word, l.Form, l.POS, tt.form, tt.pos)
+			}
+		})
+	}
+}
+
+func TestCyrillicCompound(t *testing.T) {
+	c := Analyze("китаб-митаблар")[0].Compound
+	if c == nil || len(c.Parts) != 2 || c.Parts[0].Stem != "китаб" || c.Parts[1].Stem != "митаб" {
+		t.Errorf("Analyze(китаб-митаблар) compound = %v, want [китаб митаб]", c)
+	}
+}
+
+func TestStemWithCyrillic(t *testing.T) {
+	if got := StemWith("биликлиләрдән", StemInflectional); got != "биликли" {
+		t.Errorf("StemWith(биликлиләрдән, StemInflectional) = %q, want биликли", got)
+	}
+}
+
+// TestRussianCyrillic checks words whose transliteration keeps Cyrillic
+// letters: they are analysed as is instead of recursing.
+func TestRussianCyrillic(t *testing.T) {
+	for _, w := range []string{"я", "энциклопедия", "Энциклопедия", "китаб-энциклопедия", "юрист'ин"} {
+		t.Run(w, func(t *testing.T) {
+			if got := Stem(w); got == "" {
+				t.Errorf("Stem(%q) = %q", w, got)
+			}
+			if got := StemWith(w, StemLight); got == "" {
+				t.Errorf("StemWith(%q, StemLight) = %q", w, got)
+			}
+			if got := Analyze(w); len(got) == 0 {
+				t.Errorf("Analyze(%q) returned no analyses", w)
+			}
+			if got := Lemmatize(w); got.Form == "" {
+				t.Errorf("Lemmatize(%q) = %v", w, got)
+			}
+			if got := AnalyzeSentence([]string{"Bakı", w}); len(got) != 2 {
+				t.Errorf("AnalyzeSentence(Bakı %q) = %v", w, got)
+			}
+			if got := Segment(w); len(got) == 0 {
+				t.Errorf("Segment(%q) returned no pieces", w)
+			}
+			if got := GlossSentence([]string{w}); len(got) != 1 {
+				t.Errorf("GlossSentence(%q) = %v", w, got)
+			}
+			if err := WriteCoNLLU(io.Discard, "Bakı "+w); err != nil {
+				t.Errorf("WriteCoNLLU(%q) = %v", w, err)
+			}
+		})
+	}
+	if got := Stem("я"); got != "я" {
+		t.Errorf("Stem(я) = %q, want я", got)
+	}
+}
+
+func BenchmarkAnalyzeCyrillic(b *testing.B) {
+	for b.Loop() {
+		Analyze("китабларымыздан")
+	}
+}
+
+func ExampleAnalyze_cyrillic() {
+	fmt.Println(Analyze("китабларымыздан")[0])
+	fmt.Println(Stem("Ушағы"))
+	// Output:
+	// китаб[Plural:лар|Poss1Pl:ымыз|CaseAbl:дан]
+	// Ушаг
+}
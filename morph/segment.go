@@ -0,0 +1,92 @@
+// Morpheme-aware subword segmentation for Azerbaijani.
+//
+// Segment cuts a word at the morpheme boundaries of its most confident
+// analysis, for use as linguistically motivated subwords when training
+// language models. The first piece is the stem; every further piece is
+// one suffix marked with ContinuationPrefix, as in WordPiece vocabularies:
+// kitablarımızdan → kitab ##lar ##ımız ##dan. Pieces are cut from the
+// word itself, so removing the prefixes and joining them gives the word
+// back even where the analysis restores an underlying form (uşağı →
+// uşağ ##ı, analysed as uşaq + ı).
+package morph
+
+import (
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// ContinuationPrefix marks pieces that continue the previous piece of
+// the same word.
+const ContinuationPrefix = "##"
+
+// Segment splits word into morpheme pieces following the first analysis
+// of Analyze: the stem, then each suffix prefixed with ContinuationPrefix.
+// Returns nil for empty input, and the whole word as one piece if it has
+// no suffixes or exceeds maxWordBytes.
+func Segment(word string) []string {
+	return defaultAnalyzer.Segment(word)
+}
+
+// Segment is like the package-level Segment but analyzes with the
+// Analyzer's lexicon.
+func (an *Analyzer) Segment(word string) []string {
+	if word == "" {
+		return nil
+	}
+	if len(word) > maxWordBytes {
+		return []string{word}
+	}
+	word = azcase.ComposeNFC(word)
+	a := an.Analyze(word)[0]
+	runes := []rune(word)
+	n := len([]rune(a.Stem))
+	total := n
+	for _, m := range a.Morphemes {
+		total += len([]rune(m.Surface))
+	}
+	if total != len(runes) {
+		return []string{word}
+	}
+	pieces := []string{string(runes[:n])}
+	for _, m := range a.Morphemes {
+		l := len([]rune(m.Surface))
+		pieces = append(pieces, ContinuationPrefix+string(runes[n:n+l]))
+		n += l
+	}
+	return pieces
+}
+
+// Segments splits each word with Segment and returns all pieces in order.
+// Designed to be used with tokenizer.Words().
+// Returns nil if the input is nil.
+func Segments(words []string) []string {
+	return defaultAnalyzer.Segments(words)
+}
+
+// Segments is like the package-level Segments but uses the Analyzer's
+// lexicon.
+func (an *Analyzer) Segments(words []string) []string {
+	if words == nil {
+		return nil
+	}
+	out := make([]string, 0, 2*len(words))
+	for _, w := range words {
+		out = append(out, an.Segment(w)...)
+	}
+	return out
+}
+
+// JoinSegments reverses Segments: it glues every piece that starts with
+// ContinuationPrefix to the piece before it and returns the words.
+func JoinSegments(pieces []string) []string {
+	var out []string
+	for _, p := range pieces {
+		if rest, ok := strings.CutPrefix(p, ContinuationPrefix); ok && len(out) > 0 {
+			out[len(out)-1] += rest
+			continue
+		}
+		out = append(out, p)
+	}
+	return out
+}
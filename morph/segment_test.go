@@ -0,0 +1,72 @@
+package morph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestSegment(t *testing.T) {
+	tests := []struct {
+		word string
+		want []string
+	}{
+		{"kitablarımızdan", []string{"kitab", "##lar", "##ımız", "##dan"}},
+		{"evlərdə", []string{"ev", "##lər", "##də"}},
+		{"Gəlmədim", []string{"Gəl", "##mə", "##di", "##m"}},
+		{"onlara", []string{"o", "##nlar", "##a"}},
+
+		// -- Pieces keep the surface form --
+		{"uşağı", []string{"uşağ", "##ı"}},
+		{"oğlum", []string{"oğlu", "##m"}},
+
+		// -- Whole-word pieces --
+		{"kitab", []string{"kitab"}},
+		{"Bakı'ya", []string{"Bakı'ya"}},
+		{"", nil},
+		{strings.Repeat("a", maxWordBytes+1), []string{strings.Repeat("a", maxWordBytes+1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			if got := Segment(tt.word); !slices.Equal(got, tt.want) {
+				t.Errorf("Segment(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSegmentMatchesAnalyze(t *testing.T) {
+	for _, w := range []string{"kitablarımızdan", "gəlmişdir", "evlərimizdə", "yoldaşlıq", "mənimlə"} {
+		a := Analyze(w)[0]
+		got := Segment(w)
+		if len(got) != len(a.Morphemes)+1 || got[0] != a.Stem {
+			t.Errorf("Segment(%q) = %q, want pieces of %v", w, got, a)
+		}
+	}
+}
+
+func TestSegmentsRoundTrip(t *testing.T) {
+	words := []string{"Kitablarımızdan", "uşağı", "oxuyurdular", "gözəllik", "kitab-mitablar", "dəmiryolda", "1990", "ürəyimizdəki"}
+	if got := JoinSegments(Segments(words)); !slices.Equal(got, words) {
+		t.Errorf("JoinSegments(Segments(%q)) = %q", words, got)
+	}
+	if Segments(nil) != nil {
+		t.Error("Segments(nil) != nil")
+	}
+	if got := JoinSegments([]string{"##lar", "kitab"}); !slices.Equal(got, []string{"##lar", "kitab"}) {
+		t.Errorf("JoinSegments with leading continuation = %q", got)
+	}
+}
+
+func BenchmarkSegment(b *testing.B) {
+	for b.Loop() {
+		Segment("kitablarımızdan")
+	}
+}
+
+func ExampleSegment() {
+	fmt.Println(Segment("kitablarımızdan"))
+	// Output: [kitab ##lar ##ımız ##dan]
+}
@@ -0,0 +1,135 @@
+// Stemming levels for Azerbaijani morphological analysis.
+//
+// Stem strips every suffix it recognizes, derivational ones included, so
+// bilikli and biliksiz both reduce to bilik and kitabçı to kitab. Search
+// indexing often needs lighter stemming that keeps derived words apart.
+// StemWith takes the analysis Stem selects and strips only the trailing
+// suffixes of the requested level; the first suffix outside the level
+// stops the stripping, so inner suffixes are never removed.
+package morph
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// StemLevel selects which suffixes StemWith strips.
+type StemLevel int
+
+const (
+	StemAggressive   StemLevel = iota // all suffixes, derivations included (same as Stem)
+	StemInflectional                  // nominal and verbal inflection; derivations and voice are kept
+	StemLight                         // nominal inflection only: number, possessive, case, copula, question
+)
+
+// stemLevelNames maps StemLevel values to their string names.
+var stemLevelNames = [...]string{
+	StemAggressive:   "Aggressive",
+	StemInflectional: "Inflectional",
+	StemLight:        "Light",
+}
+
+// String returns the name of the stemming level.
+func (l StemLevel) String() string {
+	if int(l) >= 0 && int(l) < len(stemLevelNames) {
+		return stemLevelNames[l]
+	}
+	return fmt.Sprintf("StemLevel(%d)", int(l))
+}
+
+// strips reports whether suffixes tagged t are removed at level l.
+func (l StemLevel) strips(t MorphTag) bool {
+	switch l {
+	case StemAggressive:
+		return true
+	case StemInflectional:
+		return !isDerivTag(t) && !isVoiceTag(t)
+	case StemLight:
+		return (t >= Plural && t <= CaseIns) || t == Copula || t == Question
+	}
+	return false
+}
+
+// isDerivTag reports whether t is a derivational suffix tag.
+func isDerivTag(t MorphTag) bool {
+	return t >= derivBase && t < copBase
+}
+
+// isVoiceTag reports whether t is a voice suffix tag. Voice forms a new
+// verb lexeme (yaz → yazdır, yazıl), so only aggressive stemming strips it.
+func isVoiceTag(t MorphTag) bool {
+	return t >= vvoiceBase && t < vnegBase
+}
+
+// StemWith extracts the stem of word, stripping only the suffixes of
+// the given level. StemAggressive is equivalent to Stem; StemInflectional
+// keeps derivational and voice suffixes (bilikliyə → bilikli, not bil);
+// StemLight also keeps verbal inflection (gəldim → gəldim).
+// Returns the original word if it cannot be analyzed or exceeds
+// maxWordBytes, and Stem's result for an unknown level. Cyrillic input
+// gives a Cyrillic stem.
+func StemWith(word string, level StemLevel) string {
+	return defaultAnalyzer.StemWith(word, level)
+}
+
+// StemWith is like the package-level StemWith but consults the
+// Analyzer's lexicon. An exception entry for word is returned as is at
+// every level.
+func (an *Analyzer) StemWith(word string, level StemLevel) string {
+	if level == StemAggressive || int(level) < 0 || int(level) >= len(stemLevelNames) ||
+		word == "" || len(word) > maxWordBytes {
+		return an.Stem(word)
+	}
+	word = azcase.ComposeNFC(word)
+	if m, ok := newScriptMap(word); ok {
+		return m.piece(an.StemWith(m.latin, level), 0)
+	}
+
+	// Hyphenated words are stemmed part by part, as in Stem.
+	if _, ok := an.exception(word); !ok {
+		if idx := strings.Index(word, "-"); idx > 0 && idx < len(word)-1 {
+			parts := strings.Split(word, "-")
+			for i, p := range parts {
+				parts[i] = an.StemWith(p, level)
+			}
+			return strings.Join(parts, "-")
+		}
+	}
+	if stem, ok := an.directStem(word); ok {
+		return stem
+	}
+
+	results := an.candidates(word)
+	stem := an.selectStem(word, results)
+	i := an.stemIndex(results, stem)
+	if i < 0 {
+		return stem
+	}
+	ms := results[i].Morphemes
+	keep := len(ms)
+	for keep > 0 && level.strips(ms[keep-1].Tag) {
+		keep--
+	}
+	if keep == 0 {
+		return stem
+	}
+	if keep == len(ms) {
+		return word
+	}
+	// The analysis undoes k/q softening at every boundary (gələcəy+əm is
+	// gəl+əcək+əm), so the kept prefix is cut from word and only its last
+	// rune, which loses its vowel-initial suffix, is taken from the analysis.
+	kept := []rune(results[i].Stem)
+	for _, m := range ms[:keep] {
+		kept = append(kept, []rune(m.Surface)...)
+	}
+	out := []rune(word)[:len(kept)]
+	last := kept[len(kept)-1]
+	if r := out[len(out)-1]; r != azcase.Lower(r) {
+		last = azcase.Upper(last)
+	}
+	out[len(out)-1] = last
+	return string(out)
+}
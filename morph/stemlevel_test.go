@@ -0,0 +1,109 @@
+package morph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestStemWith(t *testing.T) {
+	tests := []struct {
+		word  string
+		level StemLevel
+		want  string
+	}{
+		// -- Aggressive matches Stem --
+		{"bilikli", StemAggressive, "bilik"},
+		{"kitabçılığımızı", StemAggressive, "kitab"},
+		{"gəlmədim", StemAggressive, "gəl"},
+
+		// -- Inflectional keeps derivations --
+		{"bilikli", StemInflectional, "bilikli"},
+		{"biliklilər", StemInflectional, "bilikli"},
+		{"bilikdən", StemInflectional, "bilik"},
+		{"kitabçılar", StemInflectional, "kitabçı"},
+		{"evlərimizdən", StemInflectional, "ev"},
+		{"gəlmədim", StemInflectional, "gəl"},
+		{"gələcəyəm", StemInflectional, "gəl"},
+
+		// -- k/q softening is undone on the kept stem --
+		{"yoldaşlığı", StemInflectional, "yoldaşlıq"},
+		{"kitabçılığımızı", StemInflectional, "kitabçılıq"},
+		{"uşaqcığa", StemInflectional, "uşaqcıq"},
+		{"KİTABÇILIĞI", StemInflectional, "KİTABÇILIQ"},
+
+		// -- Inflectional keeps voice --
+		{"yaxşılaşdırılmış", StemInflectional, "yaxşılaşdırıl"},
+
+		// -- Light keeps verbal inflection --
+		{"biliklidir", StemLight, "bilikli"},
+		{"müəllimlərimiz", StemLight, "müəllim"},
+		{"gəlmədim", StemLight, "gəlmədim"},
+		{"gələcəyəm", StemLight, "gələcəyəm"},
+
+		// -- Direct stems at every level --
+		{"onlara", StemLight, "o"},
+		{"Azərbaycan'a", StemInflectional, "Azərbaycan"},
+		{"kitablar-dəftərlər", StemLight, "kitab-dəftər"},
+
+		// -- Edge cases --
+		{"", StemLight, ""},
+		{"kitab", StemLight, "kitab"},
+		{"kitablar", StemLevel(99), "kitab"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.word+"/"+tt.level.String(), func(t *testing.T) {
+			if got := StemWith(tt.word, tt.level); got != tt.want {
+				t.Errorf("StemWith(%q, %v) = %q, want %q", tt.word, tt.level, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStemWithAggressiveMatchesStem(t *testing.T) {
+	for _, w := range []string{"kitablarımızdan", "oğlum", "gəlmədim", "qap-qara", "Bakıya", "mənim"} {
+		if got, want := StemWith(w, StemAggressive), Stem(w); got != want {
+			t.Errorf("StemWith(%q, StemAggressive) = %q, want Stem = %q", w, got, want)
+		}
+	}
+}
+
+func TestStemWithAnalyzer(t *testing.T) {
+	an := NewAnalyzer(Options{Exceptions: map[string]string{"bilikliyə": "bilik"}})
+	if got := an.StemWith("bilikliyə", StemInflectional); got != "bilik" {
+		t.Errorf("StemWith(bilikliyə) = %q, want exception stem bilik", got)
+	}
+}
+
+func TestStemLevelString(t *testing.T) {
+	tests := []struct {
+		level StemLevel
+		want  string
+	}{
+		{StemAggressive, "Aggressive"},
+		{StemInflectional, "Inflectional"},
+		{StemLight, "Light"},
+		{StemLevel(99), "StemLevel(99)"},
+	}
+	for _, tt := range tests {
+		if got := tt.level.String(); got != tt.want {
+			t.Errorf("StemLevel(%d).String() = %q, want %q", int(tt.level), got, tt.want)
+		}
+	}
+}
+
+func BenchmarkStemWith(b *testing.B) {
+	for b.Loop() {
+		StemWith("kitabçılığımızdan", StemInflectional)
+	}
+}
+
+func ExampleStemWith() {
+	for _, level := range []StemLevel{StemAggressive, StemInflectional, StemLight} {
+		fmt.Println(level, StemWith("biliklilərdən", level), StemWith("gəlmədim", level))
+	}
+	// Output:
+	// Aggressive bilik gəl
+	// Inflectional bilikli gəl
+	// Light bilikli gəlmədim
+}
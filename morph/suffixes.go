@@ -5,18 +5,21 @@ package morph
 type fsmState int
 
 const (
-	initial         fsmState = iota // entry point for both noun and verb chains
-	afterCopula                     // after copula -dir (near-terminal for nouns)
-	afterQuestion                   // after question particle -mi (terminal)
-	nounAfterCase                   // after a case suffix (noun chain)
-	nounAfterPoss                   // after a possessive suffix (noun chain)
-	nounAfterPlural                 // after plural -lar/-ler (noun chain)
-	nounAfterDeriv                  // after a derivational suffix (noun chain)
-	verbAfterPerson                 // after a person suffix (verb chain)
-	verbAfterTense                  // after a tense/mood/participle suffix (verb chain)
-	verbAfterNeg                    // after negation -ma/-me (verb chain)
-	verbAfterVoice                  // after a voice suffix (verb chain)
-	stem                            // terminal state: remaining string is a stem candidate
+	initial          fsmState = iota // entry point for both noun and verb chains
+	afterCopula                      // after copula -dir (near-terminal for nouns)
+	afterQuestion                    // after question particle -mi (terminal)
+	nounAfterCase                    // after a case suffix (noun chain)
+	nounAfterPoss                    // after a possessive suffix (noun chain)
+	nounAfterPlural                  // after plural -lar/-ler (noun chain)
+	nounAfterDeriv                   // after a derivational suffix (noun chain)
+	verbAfterPerson                  // after a person suffix (verb chain)
+	verbAfterTense                   // after a tense/mood/participle suffix (verb chain)
+	verbAfterNeg                     // after negation -ma/-me (verb chain)
+	verbAfterVoice                   // after a voice suffix (verb chain)
+	verbAfterConverb                 // after a converb (terminal, verb chain)
+	verbAfterRelPart                 // after a relative participle (terminal, or a post-consonant possessive)
+	verbAfterDeriv                   // after a verb-forming derivation -laş/-lan (verb chain)
+	stem                             // terminal state: remaining string is a stem candidate
 )
 
 // harmonyKind classifies the vowel harmony pattern of a suffix.
@@ -66,7 +69,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131m", "im", "um", "\u00FCm", "m"},
 		tag:        Poss1Sg,
-		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv},
+		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv, verbAfterRelPart},
 		toState:    nounAfterPoss,
 		harmony:    fourWay,
 	},
@@ -75,7 +78,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131n", "in", "un", "\u00FCn", "n"},
 		tag:        Poss2Sg,
-		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv},
+		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv, verbAfterRelPart},
 		toState:    nounAfterPoss,
 		harmony:    fourWay,
 	},
@@ -84,7 +87,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"s\u0131", "si", "su", "s\u00FC", "\u0131", "i", "u", "\u00FC"},
 		tag:        Poss3Sg,
-		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv},
+		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv, verbAfterRelPart},
 		toState:    nounAfterPoss,
 		harmony:    fourWay,
 	},
@@ -97,7 +100,7 @@ var suffixRules = []suffixRule{
 			"m\u0131z", "miz", "muz", "m\u00FCz",
 		},
 		tag:        Poss1Pl,
-		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv},
+		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv, verbAfterRelPart},
 		toState:    nounAfterPoss,
 		harmony:    fourWay,
 	},
@@ -110,7 +113,7 @@ var suffixRules = []suffixRule{
 			"n\u0131z", "niz", "nuz", "n\u00FCz",
 		},
 		tag:        Poss2Pl,
-		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv},
+		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv, verbAfterRelPart},
 		toState:    nounAfterPoss,
 		harmony:    fourWay,
 	},
@@ -119,7 +122,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"lar\u0131", "l\u0259ri"},
 		tag:        Poss3Pl,
-		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv},
+		fromStates: []fsmState{initial, nounAfterPlural, nounAfterDeriv, verbAfterRelPart},
 		toState:    nounAfterPoss,
 		harmony:    backFront,
 	},
@@ -230,10 +233,74 @@ var suffixRules = []suffixRule{
 		surfaces:   []string{"la\u015F", "l\u0259\u015F"},
 		tag:        DerivVerb,
 		fromStates: []fsmState{initial, nounAfterDeriv},
-		toState:    verbAfterVoice,
+		toState:    verbAfterDeriv,
+		harmony:    backFront,
+	},
+
+	// Derivational Verb (reflexive): -lan / -l\u0259n (denominal verb, be/get; evl\u0259n, hirsl\u0259n)
+	{
+		surfaces:   []string{"lan", "l\u0259n"},
+		tag:        DerivVerbRefl,
+		fromStates: []fsmState{initial, nounAfterDeriv},
+		toState:    verbAfterDeriv,
 		harmony:    backFront,
 	},
 
+	// Derivational Fellow: -da\u015F (invariant; yolda\u015F, v\u0259t\u0259nda\u015F)
+	{
+		surfaces:   []string{"da\u015F"},
+		tag:        DerivFellow,
+		fromStates: []fsmState{initial, nounAfterDeriv},
+		toState:    nounAfterDeriv,
+		harmony:    noHarmony,
+	},
+
+	// Derivational Place: -xana, -istan / -stan (invariant; kitabxana, G\u00FCrc\u00FCstan)
+	{
+		surfaces:   []string{"istan", "xana", "stan"},
+		tag:        DerivPlace,
+		fromStates: []fsmState{initial, nounAfterDeriv},
+		toState:    nounAfterDeriv,
+		harmony:    noHarmony,
+	},
+
+	// Derivational Diminutive: -c\u0131q / -cik / -cuq / -c\u00FCk
+	{
+		surfaces:   []string{"c\u0131q", "cik", "cuq", "c\u00FCk"},
+		tag:        DerivDim,
+		fromStates: []fsmState{initial, nounAfterDeriv},
+		toState:    nounAfterDeriv,
+		harmony:    fourWay,
+	},
+
+	// Derivational Equative: -ca / -c\u0259 (manner, language; u\u015Faqca, t\u00FCrkc\u0259)
+	{
+		surfaces:   []string{"ca", "c\u0259"},
+		tag:        DerivEquative,
+		fromStates: []fsmState{initial, nounAfterDeriv},
+		toState:    nounAfterDeriv,
+		harmony:    backFront,
+	},
+
+	// Derivational Relational: -sal / -s\u0259l (relational adjective)
+	{
+		surfaces:   []string{"sal", "s\u0259l"},
+		tag:        DerivRel,
+		fromStates: []fsmState{initial, nounAfterDeriv},
+		toState:    nounAfterDeriv,
+		harmony:    backFront,
+	},
+
+	// Derivational Verb Agent: -\u0131c\u0131 / -ici / -ucu / -\u00FCc\u00FC (deverbal agent noun;
+	// dinl\u0259yici, yand\u0131r\u0131c\u0131), a verb-to-noun transition
+	{
+		surfaces:   []string{"\u0131c\u0131", "ici", "ucu", "\u00FCc\u00FC"},
+		tag:        DerivVerbAgent,
+		fromStates: []fsmState{initial, verbAfterVoice, verbAfterDeriv},
+		toState:    nounAfterDeriv,
+		harmony:    fourWay,
+	},
+
 	// Copula: -d\u0131r / -dir / -dur / -d\u00FCr (standard),
 	//         -t\u0131r / -tir / -tur / -t\u00FCr (d->t after voiceless)
 	{
@@ -258,7 +325,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"ma", "m\u0259"},
 		tag:        Negation,
-		fromStates: []fsmState{initial, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterNeg,
 		harmony:    backFront,
 	},
@@ -267,7 +334,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131l", "il", "ul", "\u00FCl"},
 		tag:        VoicePass,
-		fromStates: []fsmState{initial},
+		fromStates: []fsmState{initial, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterVoice,
 		harmony:    fourWay,
 	},
@@ -276,7 +343,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131n", "in", "un", "\u00FCn"},
 		tag:        VoiceReflex,
-		fromStates: []fsmState{initial},
+		fromStates: []fsmState{initial, verbAfterDeriv},
 		toState:    verbAfterVoice,
 		harmony:    fourWay,
 	},
@@ -285,7 +352,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131\u015F", "i\u015F", "u\u015F", "\u00FC\u015F"},
 		tag:        VoiceRecip,
-		fromStates: []fsmState{initial},
+		fromStates: []fsmState{initial, verbAfterDeriv},
 		toState:    verbAfterVoice,
 		harmony:    fourWay,
 	},
@@ -299,6 +366,20 @@ var suffixRules = []suffixRule{
 		harmony:    noHarmony,
 	},
 
+	// Voice Causative (consonant form): -d\u0131r / -dir / -dur / -d\u00FCr (standard),
+	//                                  -t\u0131r / -tir / -tur / -t\u00FCr (d->t after voiceless)
+	// Stacks on derived verbs and other voices (yax\u015F\u0131la\u015Fd\u0131r, g\u00F6r\u00FC\u015Fd\u00FCr).
+	{
+		surfaces: []string{
+			"d\u0131r", "dir", "dur", "d\u00FCr",
+			"t\u0131r", "tir", "tur", "t\u00FCr",
+		},
+		tag:        VoiceCaus,
+		fromStates: []fsmState{initial, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterVoice,
+		harmony:    fourWay,
+	},
+
 	// Voice Causative (vowel form): -\u0131r / -ir / -ur / -\u00FCr
 	{
 		surfaces:   []string{"\u0131r", "ir", "ur", "\u00FCr"},
@@ -316,7 +397,7 @@ var suffixRules = []suffixRule{
 			"t\u0131", "ti", "tu", "t\u00FC",
 		},
 		tag:        TensePastDef,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterTense},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv, verbAfterTense},
 		toState:    verbAfterTense,
 		harmony:    fourWay,
 	},
@@ -325,7 +406,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"m\u0131\u015F", "mi\u015F", "mu\u015F", "m\u00FC\u015F"},
 		tag:        TensePastIndef,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    fourWay,
 	},
@@ -334,7 +415,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131b", "ib", "ub", "\u00FCb"},
 		tag:        TensePastEvi,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    fourWay,
 	},
@@ -343,7 +424,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"\u0131r", "ir", "ur", "\u00FCr"},
 		tag:        TensePresent,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    fourWay,
 	},
@@ -352,7 +433,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"acaq", "\u0259c\u0259k"},
 		tag:        TenseFuture,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    backFront,
 	},
@@ -361,7 +442,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"ar", "\u0259r"},
 		tag:        TenseAorist,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    backFront,
 	},
@@ -370,7 +451,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"mal\u0131", "m\u0259li"},
 		tag:        MoodOblig,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    backFront,
 	},
@@ -379,7 +460,7 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"sa", "s\u0259"},
 		tag:        MoodCond,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    backFront,
 	},
@@ -388,35 +469,91 @@ var suffixRules = []suffixRule{
 	{
 		surfaces:   []string{"an", "\u0259n"},
 		tag:        Participle,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    backFront,
 	},
 
-	// Gerund (manner): -araq / -\u0259r\u0259k (back/front alternation)
+	// Relative participle (past/present): -d\u0131q / -dik / -duq / -d\u00FCk (standard),
+	//                                      -t\u0131q / -tik / -tuq / -t\u00FCk (d->t after voiceless)
+	// Usually followed by a possessive (yazdığım kitab, oxuduğu məqalə).
+	{
+		surfaces: []string{
+			"d\u0131q", "dik", "duq", "d\u00FCk",
+			"t\u0131q", "tik", "tuq", "t\u00FCk",
+		},
+		tag:        ParticiplePast,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterRelPart,
+		harmony:    fourWay,
+	},
+
+	// Relative participle (future): -acaq / -\u0259c\u0259k (back/front alternation)
+	// Usually followed by a possessive (oxuyacağım kitab); the bare form is
+	// also TenseFuture, which ranks first.
+	{
+		surfaces:   []string{"acaq", "\u0259c\u0259k"},
+		tag:        ParticipleFuture,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterRelPart,
+		harmony:    backFront,
+	},
+
+	// Converb (sequential): -\u0131b / -ib / -ub / -\u00FCb (having done; gəlib getdi)
+	{
+		surfaces:   []string{"\u0131b", "ib", "ub", "\u00FCb"},
+		tag:        ConverbSeq,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterConverb,
+		harmony:    fourWay,
+	},
+
+	// Converb (manner): -araq / -\u0259r\u0259k (back/front alternation)
 	{
 		surfaces:   []string{"araq", "\u0259r\u0259k"},
-		tag:        Gerund,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
-		toState:    verbAfterTense,
+		tag:        ConverbManner,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterConverb,
+		harmony:    backFront,
+	},
+
+	// Converb (simultaneous): -anda / -\u0259nd\u0259 (when/while doing)
+	{
+		surfaces:   []string{"anda", "\u0259nd\u0259"},
+		tag:        ConverbWhile,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterConverb,
 		harmony:    backFront,
 	},
 
-	// Converb (negative purpose): -madan / -mədən (without doing)
+	// Converb (negative): -madan / -m\u0259d\u0259n (without doing)
 	{
 		surfaces:   []string{"madan", "m\u0259d\u0259n"},
-		tag:        Gerund,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
-		toState:    verbAfterTense,
+		tag:        ConverbWithout,
+		fromStates: []fsmState{initial, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterConverb,
 		harmony:    backFront,
 	},
 
-	// Converb (temporal): -ınca / -incə / -unca / -üncə (when/upon doing)
+	// Converb (temporal): -\u0131nca / -inc\u0259 / -unca / -\u00FCnc\u0259 (until/upon doing)
 	{
 		surfaces:   []string{"\u0131nca", "inc\u0259", "unca", "\u00FCnc\u0259"},
-		tag:        Gerund,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
-		toState:    verbAfterTense,
+		tag:        ConverbUpon,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterConverb,
+		harmony:    fourWay,
+	},
+
+	// Converb (temporal): -d\u0131qda / -dikd\u0259 / -duqda / -d\u00FCkd\u0259 (when/after doing),
+	//                     -t\u0131qda / -tikd\u0259 / -tuqda / -t\u00FCkd\u0259 (d->t after voiceless)
+	{
+		surfaces: []string{
+			"d\u0131qda", "dikd\u0259", "duqda", "d\u00FCkd\u0259",
+			"t\u0131qda", "tikd\u0259", "tuqda", "t\u00FCkd\u0259",
+		},
+		tag:        ConverbWhen,
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
+		toState:    verbAfterConverb,
 		harmony:    fourWay,
 	},
 
@@ -428,7 +565,7 @@ var suffixRules = []suffixRule{
 			"t\u0131qca", "tikc\u0259", "tuqca", "t\u00FCkc\u0259",
 		},
 		tag:        Gerund,
-		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice},
+		fromStates: []fsmState{initial, verbAfterNeg, verbAfterVoice, verbAfterDeriv},
 		toState:    verbAfterTense,
 		harmony:    fourWay,
 	},
@@ -0,0 +1,230 @@
+// Universal Dependencies features for Azerbaijani morphological analysis.
+//
+// UPOS maps a POS to a UD part-of-speech tag, Lemma.UPOS refines it for
+// conjunctions, postpositions and particles, and Lemma.Feats maps the
+// suffix chain of a lemma's analysis to a UD FEATS string such as
+// Case=Abl|Number=Plur|Number[psor]=Plur|Person[psor]=1. Nominals get the
+// unmarked Case=Nom and Number=Sing, and finite verbs the unmarked 3rd
+// person singular, following the Turkish UD treebanks.
+package morph
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// udFeature is one UD feature-value pair.
+type udFeature struct {
+	name  string
+	value string
+}
+
+// udTagFeatures maps each MorphTag to the UD features it marks.
+// Tags absent from the map (derivations, copula, question) mark none.
+var udTagFeatures = map[MorphTag][]udFeature{
+	Plural:  {{"Number", "Plur"}},
+	Poss1Sg: {{"Number[psor]", "Sing"}, {"Person[psor]", "1"}},
+	Poss2Sg: {{"Number[psor]", "Sing"}, {"Person[psor]", "2"}},
+	Poss3Sg: {{"Number[psor]", "Sing"}, {"Person[psor]", "3"}},
+	Poss1Pl: {{"Number[psor]", "Plur"}, {"Person[psor]", "1"}},
+	Poss2Pl: {{"Number[psor]", "Plur"}, {"Person[psor]", "2"}},
+	Poss3Pl: {{"Number[psor]", "Plur"}, {"Person[psor]", "3"}},
+
+	CaseGen: {{"Case", "Gen"}},
+	CaseDat: {{"Case", "Dat"}},
+	CaseAcc: {{"Case", "Acc"}},
+	CaseLoc: {{"Case", "Loc"}},
+	CaseAbl: {{"Case", "Abl"}},
+	CaseIns: {{"Case", "Ins"}},
+
+	VoicePass:   {{"Voice", "Pass"}},
+	VoiceReflex: {{"Reflex", "Yes"}},
+	VoiceRecip:  {{"Voice", "Rcp"}},
+	VoiceCaus:   {{"Voice", "Cau"}},
+
+	Negation: {{"Polarity", "Neg"}},
+
+	TensePastDef:   {{"Tense", "Past"}, {"Evident", "Fh"}},
+	TensePastIndef: {{"Tense", "Past"}, {"Evident", "Nfh"}},
+	TensePresent:   {{"Tense", "Pres"}, {"Aspect", "Prog"}},
+	TenseFuture:    {{"Tense", "Fut"}},
+	TenseAorist:    {{"Tense", "Pres"}, {"Aspect", "Hab"}},
+	TensePastEvi:   {{"Tense", "Past"}, {"Aspect", "Perf"}, {"Evident", "Nfh"}},
+
+	MoodOblig: {{"Mood", "Nec"}},
+	MoodCond:  {{"Mood", "Cnd"}},
+	MoodImper: {{"Mood", "Imp"}},
+
+	Participle:       {{"VerbForm", "Part"}, {"Tense", "Pres"}},
+	ParticipleAdj:    {{"VerbForm", "Part"}, {"Tense", "Past"}},
+	Gerund:           {{"VerbForm", "Vnoun"}},
+	ParticiplePast:   {{"VerbForm", "Part"}, {"Tense", "Past"}},
+	ParticipleFuture: {{"VerbForm", "Part"}, {"Tense", "Fut"}},
+
+	Pers1Sg: {{"Person", "1"}, {"Number", "Sing"}},
+	Pers2Sg: {{"Person", "2"}, {"Number", "Sing"}},
+	Pers1Pl: {{"Person", "1"}, {"Number", "Plur"}},
+	Pers2Pl: {{"Person", "2"}, {"Number", "Plur"}},
+	Pers3:   {{"Person", "3"}},
+
+	ConverbSeq:     {{"VerbForm", "Conv"}},
+	ConverbManner:  {{"VerbForm", "Conv"}},
+	ConverbWhile:   {{"VerbForm", "Conv"}},
+	ConverbWithout: {{"VerbForm", "Conv"}, {"Polarity", "Neg"}},
+	ConverbUpon:    {{"VerbForm", "Conv"}},
+	ConverbWhen:    {{"VerbForm", "Conv"}},
+}
+
+// udPronounFeatures lists the lexical features of the pronoun lemmas.
+var udPronounFeatures = map[string][]udFeature{
+	"mən": {{"PronType", "Prs"}, {"Person", "1"}, {"Number", "Sing"}},
+	"sən": {{"PronType", "Prs"}, {"Person", "2"}, {"Number", "Sing"}},
+	"o":   {{"PronType", "Prs"}, {"Person", "3"}},
+	"biz": {{"PronType", "Prs"}, {"Person", "1"}, {"Number", "Plur"}},
+	"siz": {{"PronType", "Prs"}, {"Person", "2"}, {"Number", "Plur"}},
+	"bu":  {{"PronType", "Dem"}},
+}
+
+// udPOS maps POS values to UD UPOS tags.
+var udPOS = [...]string{
+	POSUnknown: "X",
+	POSNoun:    "NOUN",
+	POSVerb:    "VERB",
+	POSAdj:     "ADJ",
+	POSAdv:     "ADV",
+	POSOther:   "X",
+	POSPronoun: "PRON",
+}
+
+// UPOS returns the Universal Dependencies part-of-speech tag of p
+// (NOUN, VERB, ADJ, ADV, PRON), or X for unknown and other categories.
+func (p POS) UPOS() string {
+	if int(p) >= 0 && int(p) < len(udPOS) {
+		return udPOS[p]
+	}
+	return "X"
+}
+
+// udClosedClass maps uninflected closed-class words to their UPOS tags.
+// The dictionary files conjunctions, postpositions and particles with the
+// adverbs, so POS alone would tag them ADV.
+var udClosedClass = map[string]string{
+	"və": "CCONJ", "amma": "CCONJ", "lakin": "CCONJ", "ancaq": "CCONJ",
+	"ya": "CCONJ", "yaxud": "CCONJ", "yoxsa": "CCONJ", "həm": "CCONJ",
+
+	"ki": "SCONJ", "çünki": "SCONJ", "əgər": "SCONJ", "sanki": "SCONJ",
+	"guya": "SCONJ",
+
+	"ilə": "ADP", "üçün": "ADP", "kimi": "ADP", "qədər": "ADP",
+	"görə": "ADP", "dək": "ADP",
+
+	"də": "PART", "da": "PART", "hətta": "PART", "məhz": "PART",
+	"axı": "PART", "mı": "PART", "mi": "PART", "mu": "PART", "mü": "PART",
+}
+
+// UPOS returns the Universal Dependencies part-of-speech tag of the
+// lemma: CCONJ, SCONJ, ADP or PART for an uninflected closed-class word
+// (və, ki, ilə, də), and otherwise the tag of its POS.
+func (l Lemma) UPOS() string {
+	if len(l.Analysis.Morphemes) == 0 {
+		if tag, ok := udClosedClass[azcase.ToLower(l.Analysis.Stem)]; ok {
+			return tag
+		}
+	}
+	return l.POS.UPOS()
+}
+
+// Feats returns the UD FEATS string of the lemma's analysis: features
+// sorted by name, multiple values of one feature joined by commas, and
+// pairs separated by |. Returns "" if the lemma has no features.
+func (l Lemma) Feats() string {
+	var fs []udFeature
+	add := func(name, value string) {
+		for _, f := range fs {
+			if f.name == name && f.value == value {
+				return
+			}
+		}
+		fs = append(fs, udFeature{name, value})
+	}
+	has := func(name string) bool {
+		return slices.ContainsFunc(fs, func(f udFeature) bool { return f.name == name })
+	}
+
+	if l.POS == POSPronoun {
+		for _, f := range udPronounFeatures[azcase.ToLower(l.Analysis.Stem)] {
+			add(f.name, f.value)
+		}
+	}
+	for _, m := range l.Analysis.Morphemes {
+		for _, f := range udTagFeatures[m.Tag] {
+			add(f.name, f.value)
+		}
+		if m.Tag == Pers3 && strings.HasPrefix(azcase.ToLower(m.Surface), "l") {
+			add("Number", "Plur")
+		}
+	}
+
+	switch l.POS {
+	case POSNoun, POSPronoun:
+		if !has("Case") {
+			add("Case", "Nom")
+		}
+		if !has("Number") && l.POS == POSNoun {
+			add("Number", "Sing")
+		}
+	case POSVerb:
+		if !has("VerbForm") {
+			if isFinite(l.Analysis) || len(l.Analysis.Morphemes) == 0 {
+				add("VerbForm", "Fin")
+			}
+			if isFinite(l.Analysis) {
+				if !has("Mood") {
+					add("Mood", "Ind")
+				}
+				if !has("Person") {
+					add("Person", "3")
+				}
+				if !has("Number") {
+					add("Number", "Sing")
+				}
+			}
+		}
+		if !has("Polarity") {
+			add("Polarity", "Pos")
+		}
+	}
+	return formatFeats(fs)
+}
+
+// formatFeats renders features in UD order: names sorted
+// case-insensitively, values of a repeated name sorted and comma-joined.
+func formatFeats(fs []udFeature) string {
+	if len(fs) == 0 {
+		return ""
+	}
+	slices.SortStableFunc(fs, func(a, b udFeature) int {
+		if c := strings.Compare(strings.ToLower(a.name), strings.ToLower(b.name)); c != 0 {
+			return c
+		}
+		return strings.Compare(a.value, b.value)
+	})
+	var sb strings.Builder
+	for i, f := range fs {
+		switch {
+		case i == 0:
+		case fs[i-1].name == f.name:
+			sb.WriteByte(',')
+			sb.WriteString(f.value)
+			continue
+		default:
+			sb.WriteByte('|')
+		}
+		sb.WriteString(f.name)
+		sb.WriteByte('=')
+		sb.WriteString(f.value)
+	}
+	return sb.String()
+}
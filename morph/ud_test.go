@@ -0,0 +1,98 @@
+package morph
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestLemmaFeats(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"kitablarımızdan", "Case=Abl|Number=Plur|Number[psor]=Plur|Person[psor]=1"},
+		{"kitab", "Case=Nom|Number=Sing"},
+		{"evə", "Case=Dat|Number=Sing"},
+		{"gəlmədi", "Evident=Fh|Mood=Ind|Number=Sing|Person=3|Polarity=Neg|Tense=Past|VerbForm=Fin"},
+		{"yazdım", "Evident=Fh|Mood=Ind|Number=Sing|Person=1|Polarity=Pos|Tense=Past|VerbForm=Fin"},
+		{"gəlirlər", "Aspect=Prog|Mood=Ind|Number=Plur|Person=3|Polarity=Pos|Tense=Pres|VerbForm=Fin"},
+		{"gəlməlisən", "Mood=Nec|Number=Sing|Person=2|Polarity=Pos|VerbForm=Fin"},
+		{"gələn", "Polarity=Pos|Tense=Pres|VerbForm=Part"},
+		{"gələrək", "Polarity=Pos|VerbForm=Conv"},
+		{"mənim", "Case=Gen|Number=Sing|Person=1|PronType=Prs"},
+		{"bunlar", "Case=Nom|Number=Plur|PronType=Dem"},
+		{"çox", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.word, func(t *testing.T) {
+			l := Lemmatize(tt.word)
+			if got := l.Feats(); got != tt.want {
+				t.Errorf("Lemmatize(%q).Feats() = %q, want %q\n  analysis: %v", tt.word, got, tt.want, l.Analysis)
+			}
+		})
+	}
+}
+
+func TestPOSUPOS(t *testing.T) {
+	tests := []struct {
+		pos  POS
+		want string
+	}{
+		{POSUnknown, "X"},
+		{POSNoun, "NOUN"},
+		{POSVerb, "VERB"},
+		{POSAdj, "ADJ"},
+		{POSAdv, "ADV"},
+		{POSOther, "X"},
+		{POSPronoun, "PRON"},
+		{POS(99), "X"},
+	}
+	for _, tt := range tests {
+		if got := tt.pos.UPOS(); got != tt.want {
+			t.Errorf("%v.UPOS() = %q, want %q", tt.pos, got, tt.want)
+		}
+	}
+}
+
+func TestLemmaUPOS(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"və", "CCONJ"},
+		{"amma", "CCONJ"},
+		{"ki", "SCONJ"},
+		{"ilə", "ADP"},
+		{"üçün", "ADP"},
+		{"də", "PART"},
+		{"çox", "ADV"},
+		{"kitablar", "NOUN"},
+		{"gəldim", "VERB"},
+		{"mənim", "PRON"},
+	}
+	for _, tt := range tests {
+		if got := Lemmatize(tt.word).UPOS(); got != tt.want {
+			t.Errorf("Lemmatize(%q).UPOS() = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestFormatFeats(t *testing.T) {
+	got := formatFeats([]udFeature{
+		{"Voice", "Pass"}, {"Number[psor]", "Sing"}, {"Voice", "Cau"}, {"number", "Plur"},
+	})
+	want := "number=Plur|Number[psor]=Sing|Voice=Cau,Pass"
+	if got != want {
+		t.Errorf("formatFeats = %q, want %q", got, want)
+	}
+	if got := formatFeats(nil); got != "" {
+		t.Errorf("formatFeats(nil) = %q, want empty", got)
+	}
+}
+
+func ExampleLemma_Feats() {
+	l := Lemmatize("kitablarımızdan")
+	fmt.Println(l.Form, l.POS.UPOS(), l.Feats())
+	// Output:
+	// kitab NOUN Case=Abl|Number=Plur|Number[psor]=Plur|Person[psor]=1
+}
@@ -0,0 +1,213 @@
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// noSpaceBefore reports whether r attaches to the text before it: closing
+// punctuation, closing quotes and brackets, and the percent sign.
+func noSpaceBefore(r rune) bool {
+	switch r {
+	case ',', '.', '!', '?', ';', ':', '…', ')', ']', '}', '»', '”', '›', '%':
+		return true
+	}
+	return false
+}
+
+// noSpaceAfter reports whether r attaches to the text after it: opening
+// quotes and brackets.
+func noSpaceAfter(r rune) bool {
+	switch r {
+	case '(', '[', '{', '«', '„', '“', '‘', '‹':
+		return true
+	}
+	return false
+}
+
+// isApostrophe reports whether r is an apostrophe that joins a suffix to
+// its stem (Bakı'nın, Bakı’nın).
+func isApostrophe(r rune) bool {
+	return r == '\'' || r == '’'
+}
+
+// detokenizer decides the spacing between consecutive pieces of text.
+type detokenizer struct {
+	b          strings.Builder
+	prev       string // last piece written, "" at the start or after whitespace
+	prevPrev   string // piece written before prev
+	quoteOpen  bool   // a straight double quote is open
+	singleOpen bool   // a straight single quote is open
+	lowOpen    bool   // a „ is open, so the next “ closes it
+	curlyOpen  bool   // a ‘ is open, so the next ’ closes it
+	closed     bool   // prev closed a „ or ‘ quote
+	apostrophe bool   // prev is a straight ' joining a suffix to its stem
+	gap        bool   // the original text separated prev and the next piece
+}
+
+// needsSpace reports whether a space goes between the last piece written
+// and next, given the piece after next ("" at the end).
+func (d *detokenizer) needsSpace(next, after string) bool {
+	if d.prev == "" {
+		return false
+	}
+	last, lastSize := utf8.DecodeLastRuneInString(d.prev)
+	first, _ := utf8.DecodeRuneInString(next)
+	switch {
+	case next == `"`:
+		return !d.quoteOpen && !noSpaceAfter(last) // an opening quote is spaced, a closing one attached
+	case next == "'" && d.joinsSuffix(after):
+		return false // Bakı ' nın -> Bakı'nın
+	case next == "'":
+		return !d.singleOpen && !noSpaceAfter(last)
+	case next == "“" && d.lowOpen, next == "’" && d.curlyOpen:
+		return false // „Salam“, ‘Salam’
+	case noSpaceBefore(first):
+		return false
+	case d.prev == `"`:
+		return !d.quoteOpen
+	case d.prev == "'" && !d.apostrophe:
+		return !d.singleOpen
+	case noSpaceAfter(last) && !d.closed:
+		return false
+	case d.gap:
+		return true
+	case isApostrophe(first) && (unicode.IsLetter(last) || unicode.IsDigit(last)):
+		return false // Bakı 'nın -> Bakı'nın
+	case isApostrophe(last) && len(d.prev) == lastSize && !d.closed && unicode.IsLetter(first):
+		return false // Bakı ’ nın -> Bakı’nın
+	case next == "-" && unicode.IsDigit(last) && startsWithLetter(after):
+		return false // 3 - cü -> 3-cü
+	case d.prev == "-" && endsWithDigit(d.prevPrev) && unicode.IsLetter(first):
+		return false
+	case d.prev == ":" && endsWithDigit(d.prevPrev) && unicode.IsDigit(first):
+		return false // 10 : 30 -> 10:30
+	}
+	return true
+}
+
+// joinsSuffix reports whether a straight ' written next is an apostrophe
+// rather than a quote: no single quote is open, and it comes between a
+// letter or digit and a lowercase suffix (Bakı ' nın), with no gap before it.
+func (d *detokenizer) joinsSuffix(after string) bool {
+	last, _ := utf8.DecodeLastRuneInString(d.prev)
+	first, _ := utf8.DecodeRuneInString(after)
+	return !d.singleOpen && !d.gap && (unicode.IsLetter(last) || unicode.IsDigit(last)) && unicode.IsLower(first)
+}
+
+// startsWithLetter reports whether s starts with a letter.
+func startsWithLetter(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsLetter(r)
+}
+
+// endsWithDigit reports whether s ends with a digit.
+func endsWithDigit(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsDigit(r)
+}
+
+// write appends piece, preceded by a space if the conventions call for one,
+// given the piece after it. A whitespace piece is written as is and
+// suppresses the next space.
+func (d *detokenizer) write(piece, after string, space bool) {
+	if piece == "" {
+		return
+	}
+	if strings.TrimSpace(piece) == "" {
+		d.b.WriteString(piece)
+		d.prev, d.prevPrev = "", ""
+		return
+	}
+	if space {
+		d.b.WriteByte(' ')
+	}
+	d.b.WriteString(piece)
+	d.closed, d.apostrophe = false, false
+	switch piece {
+	case `"`:
+		d.quoteOpen = !d.quoteOpen
+	case "'":
+		if d.apostrophe = d.joinsSuffix(after); !d.apostrophe {
+			d.singleOpen = !d.singleOpen
+		}
+	case "„":
+		d.lowOpen = true
+	case "‘":
+		d.curlyOpen = true
+	case "“":
+		d.closed, d.lowOpen = d.lowOpen, false
+	case "’":
+		d.closed, d.curlyOpen = d.curlyOpen, false
+	}
+	d.prev, d.prevPrev = piece, d.prev
+}
+
+// Detokenize joins words into text with Azerbaijani spacing conventions,
+// reversing Words or the texts of WordTokens. Words are separated by a
+// single space, except that
+//
+//   - , . ! ? ; : … % and closing brackets and quotes ) ] » ” attach to the
+//     word before them, and opening brackets and quotes ( [ « „ “ ‘ attach
+//     to the word after them;
+//   - straight quotes " and ' alternate between opening and closing, and
+//     “ and ’ close an open „ or ‘ („Salam“, ‘Salam’), but a ' between a
+//     letter or digit and a lowercase word is an apostrophe;
+//   - an apostrophe suffix attaches to its stem (Bakı 'nın, Bakı ' nın,
+//     Bakı ’ nın become Bakı'nın, Bakı’nın), an ordinal hyphen to its
+//     number (3 - cü becomes 3-cü), and a colon between numbers to both
+//     (10 : 30 becomes 10:30).
+//
+// Empty words are skipped, and whitespace-only words are copied as is with
+// no space added around them.
+func Detokenize(words []string) string {
+	var d detokenizer
+	for i, w := range words {
+		after := ""
+		if i+1 < len(words) {
+			after = words[i+1]
+		}
+		d.write(w, after, d.needsSpace(w, after))
+	}
+	return d.b.String()
+}
+
+// DetokenizeTokens rebuilds text from tokens, such as the output of
+// WordTokens after editing token texts. Space tokens are copied as is, and
+// two tokens that were adjacent in the original text (the End of one equals
+// the Start of the next) stay adjacent, so the original whitespace is kept
+// wherever the tokens still carry it. Elsewhere — after Space tokens were
+// removed, or around inserted tokens with no offsets — spacing follows the
+// Detokenize conventions, except that an apostrophe attaches to its stem
+// only if no text separated them in the original.
+// DetokenizeTokens(WordTokens(s)) == s.
+func DetokenizeTokens(tokens []Token) string {
+	var d detokenizer
+	var prev *Token
+	for i := range tokens {
+		t := &tokens[i]
+		if t.Text == "" {
+			continue
+		}
+		after := ""
+		if i+1 < len(tokens) {
+			after = tokens[i+1].Text
+		}
+		anchored := prev != nil && isAnchored(prev) && isAnchored(t)
+		d.gap = anchored && prev.End < t.Start
+		space := d.needsSpace(t.Text, after)
+		if anchored && prev.End == t.Start {
+			space = false
+		}
+		d.write(t.Text, after, space)
+		prev = t
+	}
+	return d.b.String()
+}
+
+// isAnchored reports whether t carries offsets into the original text.
+// Inserted tokens have none (Start == End).
+func isAnchored(t *Token) bool {
+	return t.Start >= 0 && t.Start < t.End
+}
@@ -0,0 +1,150 @@
+package tokenizer
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDetokenize(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []string
+		want  string
+	}{
+		{"empty", nil, ""},
+		{"words", []string{"Salam", "dünya"}, "Salam dünya"},
+		{"punctuation", []string{"Salam", ",", "dünya", "!"}, "Salam, dünya!"},
+		{"all closing marks", []string{"a", ".", "b", "?", "c", ";", "d", ":", "e", "…"}, "a. b? c; d: e…"},
+		{"cluster", []string{"Nə", "?", "!"}, "Nə?!"},
+		{"guillemets", []string{"O", "dedi", ":", "«", "Gəl", "!", "»", "Sonra", "getdi", "."}, "O dedi: «Gəl!» Sonra getdi."},
+		{"parentheses", []string{"Bakı", "(", "paytaxt", ")", "gözəldir", "."}, "Bakı (paytaxt) gözəldir."},
+		{"straight quotes", []string{"Kitab", `"`, "Dədə", "Qorqud", `"`, "adlanır", "."}, `Kitab "Dədə Qorqud" adlanır.`},
+		{"quote then period", []string{`"`, "Bəli", `"`, "."}, `"Bəli".`},
+		{"quote in parentheses", []string{"(", `"`, "A", `"`, ")"}, `("A")`},
+		{"low and high quotes", []string{"O", "„", "Salam", "“", "dedi", "."}, "O „Salam“ dedi."},
+		{"high quotes", []string{"O", "“", "Salam", "”", "dedi", "."}, "O “Salam” dedi."},
+		{"single quotes", []string{"O", "'", "Salam", "'", "dedi"}, "O 'Salam' dedi"},
+		{"curly single quotes", []string{"O", "‘", "Salam", "’", "dedi"}, "O ‘Salam’ dedi"},
+		{"apostrophe suffix", []string{"Bakı", "'nın", "küçələri"}, "Bakı'nın küçələri"},
+		{"curly apostrophe", []string{"Bakı", "’", "nın"}, "Bakı’nın"},
+		{"straight apostrophe", []string{"Bakı", "'", "nın", "küçələri"}, "Bakı'nın küçələri"},
+		{"apostrophe after number", []string{"2024", "'", "də"}, "2024'də"},
+		{"closing quote before lowercase", []string{"'", "Salam", "'", "dedi"}, "'Salam' dedi"},
+		{"apostrophe word kept", []string{"Bakı'nın", "küçələri"}, "Bakı'nın küçələri"},
+		{"ordinal", []string{"3", "-", "cü", "sinif"}, "3-cü sinif"},
+		{"dash kept", []string{"5", "-", "3"}, "5 - 3"},
+		{"word dash", []string{"Bakı", "-", "Gəncə"}, "Bakı - Gəncə"},
+		{"time", []string{"saat", "10", ":", "30"}, "saat 10:30"},
+		{"label colon", []string{"Qeyd", ":", "2", "nəfər"}, "Qeyd: 2 nəfər"},
+		{"percent", []string{"15", "%", "artım"}, "15% artım"},
+		{"empty words skipped", []string{"", "Salam", "", "dünya", ""}, "Salam dünya"},
+		{"whitespace kept", []string{"Birinci", ".", "\n\n", "İkinci", "."}, "Birinci.\n\nİkinci."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Detokenize(tt.words); got != tt.want {
+				t.Errorf("Detokenize(%q) = %q, want %q", tt.words, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestDetokenizeWordTokens rebuilds normally spaced text from its
+// non-space tokens.
+func TestDetokenizeWordTokens(t *testing.T) {
+	inputs := []string{
+		"Salam, dünya!",
+		"Bakı'nın küçələri gözəldir.",
+		"O dedi: «Sabah gələcəyəm.» Biz gözlədik.",
+		"Qiymət 1.000.000,50 manat (təxminən 15% artım) oldu.",
+		"Ətraflı: https://gov.az/news?id=1. Yazın: info@gov.az",
+		"Dərs saat 10:30-da, 3-cü sinifdə başlayır.",
+	}
+	for _, input := range inputs {
+		var texts []string
+		for _, tok := range WordTokens(input) {
+			if tok.Type != Space {
+				texts = append(texts, tok.Text)
+			}
+		}
+		if got := Detokenize(texts); got != input {
+			t.Errorf("Detokenize(%q) = %q, want %q", texts, got, input)
+		}
+	}
+}
+
+func TestDetokenizeTokens(t *testing.T) {
+	input := "Salam,  dünya!\nBakı'nın\tküçələri."
+
+	t.Run("round trip", func(t *testing.T) {
+		if got := DetokenizeTokens(WordTokens(input)); got != input {
+			t.Errorf("DetokenizeTokens(WordTokens(%q)) = %q", input, got)
+		}
+	})
+
+	t.Run("edited text keeps whitespace", func(t *testing.T) {
+		tokens := WordTokens(input)
+		for i := range tokens {
+			if tokens[i].Text == "dünya" {
+				tokens[i].Text = "Dünya"
+			}
+		}
+		want := "Salam,  Dünya!\nBakı'nın\tküçələri."
+		if got := DetokenizeTokens(tokens); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("spaces removed", func(t *testing.T) {
+		var tokens []Token
+		for _, tok := range WordTokens(input) {
+			if tok.Type != Space {
+				tokens = append(tokens, tok)
+			}
+		}
+		want := "Salam, dünya! Bakı'nın küçələri."
+		if got := DetokenizeTokens(tokens); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("spaced apostrophe", func(t *testing.T) {
+		var tokens []Token
+		for _, tok := range WordTokens("Bakı ’nın və Bakı’nın") {
+			if tok.Type != Space {
+				tokens = append(tokens, tok)
+			}
+		}
+		want := "Bakı ’nın və Bakı’nın"
+		if got := DetokenizeTokens(tokens); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+
+	t.Run("inserted and deleted", func(t *testing.T) {
+		tokens := WordTokens("Salam dünya!")
+		// Delete "dünya", insert "(" "Bakı" ")" before "!".
+		edited := append([]Token{}, tokens[:2]...)
+		edited = append(edited, Token{Text: "("}, Token{Text: "Bakı"}, Token{Text: ")"}, tokens[3])
+		want := "Salam (Bakı)!"
+		if got := DetokenizeTokens(edited); got != want {
+			t.Errorf("got %q, want %q", got, want)
+		}
+	})
+}
+
+func ExampleDetokenize() {
+	fmt.Println(Detokenize([]string{"Bakı", "'nın", "küçələri", "(", "mərkəz", ")", "gözəldir", "!"}))
+	// Output: Bakı'nın küçələri (mərkəz) gözəldir!
+}
+
+func ExampleDetokenizeTokens() {
+	tokens := WordTokens("Bakı  səhərləri,\tsakit.")
+	for i, t := range tokens {
+		if t.Text == "sakit" {
+			tokens[i].Text = "sakitdir"
+		}
+	}
+	fmt.Printf("%q\n", DetokenizeTokens(tokens))
+	// Output: "Bakı  səhərləri,\tsakitdir."
+}
@@ -0,0 +1,431 @@
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Block-level Markdown limits, as in CommonMark.
+const (
+	maxBlockIndent   = 3 // spaces before a heading, fence, or setext underline
+	maxHeadingLevel  = 6 // ###### at most
+	maxOrderedDigits = 9 // digits in an ordered list marker
+)
+
+// cutBlock is the block a sentence Scanner last cut its buffer in, which
+// the rest of the stream continues from mid-line.
+type cutBlock struct {
+	typ   TokenType // Paragraph, ListItem, Table, or CodeBlock; Heading for any one-line block
+	fence string    // opening fence of a CodeBlock
+	pipe  bool      // the cut line has a | before the cut
+	bol   bool      // the cut is at the start of a line, after a blank line in code
+}
+
+// documentTokens splits s into block-level tokens. Each block owns the
+// blank lines after it; leading blank lines belong to the first block.
+func documentTokens(s string) []Token {
+	return blockTokens(s, nil)
+}
+
+// blockTokens is documentTokens for s that continues the block cut, if it
+// is not nil, from mid-line.
+func blockTokens(s string, cut *cutBlock) []Token {
+	tokens := make([]Token, 0, len(s)/200+1)
+	start := 0 // byte offset where the current block begins
+	pos := blankLinesEnd(s, 0)
+	if cut != nil {
+		typ, end := continueBlock(s, cut)
+		end = blankLinesEnd(s, end)
+		tokens = append(tokens, Token{Text: s[:end], Start: 0, End: end, Type: typ})
+		start, pos = end, end
+	}
+	for pos < len(s) {
+		if line, _ := lineAt(s, pos); isBlank(line) {
+			break // trailing whitespace without a newline
+		}
+		typ, end, level := nextBlock(s, pos)
+		end = blankLinesEnd(s, end)
+		tokens = append(tokens, Token{
+			Text:  s[start:end],
+			Start: start,
+			End:   end,
+			Type:  typ,
+			Level: level,
+		})
+		start, pos = end, end
+	}
+
+	// Trailing whitespace belongs to the last block; a blank document is
+	// one Paragraph.
+	if start < len(s) {
+		if n := len(tokens); n > 0 {
+			tokens[n-1].End = len(s)
+			tokens[n-1].Text = s[tokens[n-1].Start:]
+		} else {
+			tokens = append(tokens, Token{Text: s, Start: 0, End: len(s), Type: Paragraph})
+		}
+	}
+	return tokens
+}
+
+// nextBlock reads the block whose first line starts at pos, which is not
+// blank, and returns its type, the end of its last line, and the level of a
+// Heading.
+func nextBlock(s string, pos int) (typ TokenType, end, level int) {
+	line, next := lineAt(s, pos)
+	switch {
+	case fenceOpen(line) != "":
+		return CodeBlock, fencedCodeEnd(s, next, fenceOpen(line)), 0
+	case headingLevel(line) > 0:
+		return Heading, next, headingLevel(line)
+	case isThematicBreak(line):
+		return Paragraph, next, 0
+	case startsTable(s, pos):
+		return Table, tableEnd(s, next), 0
+	case listMarker(line) > 0:
+		return ListItem, listItemEnd(s, next), 0
+	}
+	return paragraphEnd(s, next)
+}
+
+// continueBlock returns the type and the end of the last line of the block
+// cut, which s continues from mid-line. A paragraph or list item whose cut
+// line turns out to be a table header row becomes a Table.
+func continueBlock(s string, cut *cutBlock) (TokenType, int) {
+	line, next := lineAt(s, 0)
+	if cut.bol {
+		line, next = "", 0
+	}
+	switch cut.typ {
+	case CodeBlock:
+		return CodeBlock, fencedCodeEnd(s, next, cut.fence)
+	case Heading:
+		return Heading, next
+	case Table:
+		return Table, tableEnd(s, next)
+	}
+	if (cut.pipe || strings.Contains(line, "|")) && next < len(s) {
+		if delim, _ := lineAt(s, next); isTableDelimiter(delim) {
+			return Table, tableEnd(s, next)
+		}
+	}
+	if cut.typ == ListItem {
+		return ListItem, listItemEnd(s, next)
+	}
+	typ, end, _ := paragraphEnd(s, next)
+	return typ, end
+}
+
+// tableEnd returns the end of the table rows that start at pos.
+func tableEnd(s string, pos int) int {
+	for pos < len(s) {
+		l, n := lineAt(s, pos)
+		if isBlank(l) || !strings.Contains(l, "|") || startsBlock(s, pos, false) {
+			break
+		}
+		pos = n
+	}
+	return pos
+}
+
+// listItemEnd returns the end of the continuation lines of a list item
+// that start at pos.
+func listItemEnd(s string, pos int) int {
+	for pos < len(s) {
+		l, n := lineAt(s, pos)
+		if isBlank(l) || startsBlock(s, pos, false) {
+			break
+		}
+		pos = n
+	}
+	return pos
+}
+
+// paragraphEnd reads the continuation lines of a paragraph that start at
+// pos and returns Paragraph, or Heading with its level if a setext
+// underline ends it, and the end of its last line.
+func paragraphEnd(s string, pos int) (typ TokenType, end, level int) {
+	for pos < len(s) {
+		l, n := lineAt(s, pos)
+		if isBlank(l) {
+			break
+		}
+		if level := setextLevel(l); level > 0 {
+			return Heading, n, level
+		}
+		if startsBlock(s, pos, true) {
+			break
+		}
+		pos = n
+	}
+	return Paragraph, pos, 0
+}
+
+// blockAt returns the block that s, which continues the block cut if it is
+// not nil, is in at pos, or nil if a block starts at pos.
+func blockAt(s string, pos int, cut *cutBlock) *cutBlock {
+	for i, b := range blockTokens(s, cut) {
+		if pos >= b.End {
+			continue
+		}
+		if pos == b.Start || (i > 0 || cut == nil) && pos <= blankLinesEnd(s, b.Start) {
+			return nil // the rest starts the block, after its leading blank lines
+		}
+		lineStart := strings.LastIndexByte(s[:pos], '\n') + 1
+		at := &cutBlock{typ: b.Type, pipe: strings.Contains(s[lineStart:pos], "|"), bol: lineStart == pos}
+		if i == 0 && cut != nil {
+			at.fence = cut.fence
+			at.pipe = at.pipe || lineStart == 0 && cut.pipe
+			if at.typ == Heading && cut.typ != Heading {
+				at.typ = Paragraph // a setext heading reads on as a paragraph
+			}
+			return at
+		}
+		first, _ := lineAt(s, blankLinesEnd(s, b.Start))
+		switch {
+		case b.Type == CodeBlock:
+			at.fence = fenceOpen(first)
+		case b.Type == Heading && headingLevel(first) == 0:
+			at.typ = Paragraph
+		case b.Type == Paragraph && isThematicBreak(first):
+			at.typ = Heading
+		}
+		return at
+	}
+	return nil
+}
+
+// startsBlock reports whether the line at pos starts a new block. Inside a
+// paragraph, an ordered list interrupts only when it starts at 1 or a, so
+// that a wrapped line such as "2024. ildə" stays in the paragraph.
+func startsBlock(s string, pos int, inParagraph bool) bool {
+	line, _ := lineAt(s, pos)
+	if fenceOpen(line) != "" || headingLevel(line) > 0 || isThematicBreak(line) || startsTable(s, pos) {
+		return true
+	}
+	if listMarker(line) == 0 {
+		return false
+	}
+	if !inParagraph {
+		return true
+	}
+	_, item := indentWidth(line)
+	r, _ := utf8.DecodeRuneInString(item)
+	switch {
+	case isDigitByte(item[0]):
+		return strings.HasPrefix(item, "1.") || strings.HasPrefix(item, "1)")
+	case unicode.IsLower(r):
+		return r == 'a'
+	}
+	return true
+}
+
+// lineAt returns the line starting at pos without its line ending ("\n" or
+// "\r\n"), and the offset of the next line.
+func lineAt(s string, pos int) (line string, next int) {
+	i := strings.IndexByte(s[pos:], '\n')
+	if i < 0 {
+		return strings.TrimSuffix(s[pos:], "\r"), len(s)
+	}
+	return strings.TrimSuffix(s[pos:pos+i], "\r"), pos + i + 1
+}
+
+// isBlank reports whether line contains only whitespace.
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
+
+// blankLinesEnd returns the offset after the blank lines that start at pos.
+// Only lines ended by a newline count, so pos is returned if the line at pos
+// is not blank or runs to the end of s.
+func blankLinesEnd(s string, pos int) int {
+	for pos < len(s) {
+		line, next := lineAt(s, pos)
+		if !isBlank(line) || s[next-1] != '\n' {
+			break
+		}
+		pos = next
+	}
+	return pos
+}
+
+// indentWidth returns the width of the leading spaces and tabs of line,
+// counting a tab as four columns, and the rest of the line.
+func indentWidth(line string) (width int, rest string) {
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case ' ':
+			width++
+		case '\t':
+			width += 4
+		default:
+			return width, line[i:]
+		}
+	}
+	return width, ""
+}
+
+// headingLevel returns the level of an ATX heading line (# Başlıq), or 0.
+func headingLevel(line string) int {
+	width, rest := indentWidth(line)
+	if width > maxBlockIndent {
+		return 0
+	}
+	level := 0
+	for level < len(rest) && rest[level] == '#' {
+		level++
+	}
+	if level == 0 || level > maxHeadingLevel {
+		return 0
+	}
+	if level < len(rest) && rest[level] != ' ' && rest[level] != '\t' {
+		return 0
+	}
+	return level
+}
+
+// setextLevel returns 1 or 2 if line underlines the paragraph above it
+// with = or -, or 0.
+func setextLevel(line string) int {
+	width, rest := indentWidth(line)
+	rest = strings.TrimRight(rest, " \t")
+	if width > maxBlockIndent || rest == "" {
+		return 0
+	}
+	if strings.Trim(rest, "=") == "" {
+		return 1
+	}
+	if strings.Trim(rest, "-") == "" {
+		return 2
+	}
+	return 0
+}
+
+// isThematicBreak reports whether line is a horizontal rule: three or more
+// of the same -, *, or _, optionally separated by spaces.
+func isThematicBreak(line string) bool {
+	width, rest := indentWidth(line)
+	if width > maxBlockIndent || rest == "" {
+		return false
+	}
+	c := rest[0]
+	if c != '-' && c != '*' && c != '_' {
+		return false
+	}
+	n := 0
+	for i := 0; i < len(rest); i++ {
+		switch rest[i] {
+		case c:
+			n++
+		case ' ', '\t':
+		default:
+			return false
+		}
+	}
+	return n >= 3
+}
+
+// fenceOpen returns the opening code fence of line (``` or ~~~, possibly
+// longer), or "".
+func fenceOpen(line string) string {
+	width, rest := indentWidth(line)
+	if width > maxBlockIndent || rest == "" || rest[0] != '`' && rest[0] != '~' {
+		return ""
+	}
+	n := 0
+	for n < len(rest) && rest[n] == rest[0] {
+		n++
+	}
+	if n < 3 || rest[0] == '`' && strings.Contains(rest[n:], "`") {
+		return ""
+	}
+	return rest[:n]
+}
+
+// fencedCodeEnd returns the end of the fenced code block opened by fence
+// whose content starts at pos: after the closing fence of the same
+// character and at least the same length, or the end of s if the block is
+// never closed.
+func fencedCodeEnd(s string, pos int, fence string) int {
+	for pos < len(s) {
+		l, n := lineAt(s, pos)
+		width, rest := indentWidth(l)
+		rest = strings.TrimRight(rest, " \t")
+		if width <= maxBlockIndent && len(rest) >= len(fence) && strings.Trim(rest, fence[:1]) == "" {
+			return n
+		}
+		pos = n
+	}
+	return len(s)
+}
+
+// listMarker returns the length of the list marker that starts line,
+// including the space after it, or 0. Markers are -, *, +, and •, a number
+// of up to nine digits followed by . or ), and a single lowercase letter,
+// including ç ə ğ ı ö ş ü, followed by ) as in Azerbaijani legal texts
+// (a) bənd, ç) bənd).
+func listMarker(line string) int {
+	_, rest := indentWidth(line)
+	if rest == "" {
+		return 0
+	}
+	indent := len(line) - len(rest)
+	n := 0
+	r, size := utf8.DecodeRuneInString(rest)
+	switch {
+	case r == '-' || r == '*' || r == '+' || r == '•':
+		n = size
+	case isDigitByte(rest[0]):
+		for n < len(rest) && n < maxOrderedDigits && isDigitByte(rest[n]) {
+			n++
+		}
+		if n == len(rest) || rest[n] != '.' && rest[n] != ')' {
+			return 0
+		}
+		n++
+	case unicode.IsLower(r):
+		if size == len(rest) || rest[size] != ')' {
+			return 0
+		}
+		n = size + 1
+	default:
+		return 0
+	}
+	if n == len(rest) {
+		return indent + n
+	}
+	if rest[n] != ' ' && rest[n] != '\t' {
+		return 0
+	}
+	return indent + n + 1
+}
+
+// startsTable reports whether the line at pos is the header row of a pipe
+// table: it contains | and the next line is a delimiter row (|---|:--:|).
+func startsTable(s string, pos int) bool {
+	line, next := lineAt(s, pos)
+	if !strings.Contains(line, "|") || next >= len(s) {
+		return false
+	}
+	delim, _ := lineAt(s, next)
+	return isTableDelimiter(delim)
+}
+
+// isTableDelimiter reports whether line is a table delimiter row: cells of
+// dashes with optional alignment colons, separated by |.
+func isTableDelimiter(line string) bool {
+	line = strings.TrimSpace(line)
+	if !strings.Contains(line, "|") {
+		return false
+	}
+	line = strings.TrimPrefix(strings.TrimSuffix(line, "|"), "|")
+	for _, cell := range strings.Split(line, "|") {
+		cell = strings.TrimSpace(cell)
+		cell = strings.TrimSuffix(strings.TrimPrefix(cell, ":"), ":")
+		if cell == "" || strings.Trim(cell, "-") != "" {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,146 @@
+package tokenizer
+
+import (
+	"fmt"
+	"testing"
+)
+
+// blockStrings returns tokens as Type:Text, with the level after a Heading.
+func blockStrings(tokens []Token) []string {
+	out := make([]string, len(tokens))
+	for i, t := range tokens {
+		out[i] = t.Type.String() + ":" + t.Text
+		if t.Type == Heading {
+			out[i] = fmt.Sprintf("Heading%d:%s", t.Level, t.Text)
+		}
+	}
+	return out
+}
+
+func TestDocumentTokens(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		// -- Paragraphs --
+		{"one line", "Salam dünya.", []string{"Paragraph:Salam dünya."}},
+		{"blank line", "Birinci.\n\nİkinci.", []string{"Paragraph:Birinci.\n\n", "Paragraph:İkinci."}},
+		{"crlf", "Birinci.\r\n\r\nİkinci.\r\n", []string{"Paragraph:Birinci.\r\n\r\n", "Paragraph:İkinci.\r\n"}},
+		{"whitespace line", "Birinci.\n \t\nİkinci.", []string{"Paragraph:Birinci.\n \t\n", "Paragraph:İkinci."}},
+		{"wrapped lines", "Birinci sətir\nikinci sətir.\n\nSon.", []string{"Paragraph:Birinci sətir\nikinci sətir.\n\n", "Paragraph:Son."}},
+		{"leading blank lines", "\n\n  Mətn.\n\n  ", []string{"Paragraph:\n\n  Mətn.\n\n  "}},
+		{"only whitespace", " \n\n ", []string{"Paragraph: \n\n "}},
+		{"wrapped year", "Müqavilə\n2024. ildə imzalanıb.", []string{"Paragraph:Müqavilə\n2024. ildə imzalanıb."}},
+		{"thematic break", "Bir.\n\n---\n\nİki.", []string{"Paragraph:Bir.\n\n", "Paragraph:---\n\n", "Paragraph:İki."}},
+
+		// -- Headings --
+		{"atx", "# Giriş\nMətn burada.", []string{"Heading1:# Giriş\n", "Paragraph:Mətn burada."}},
+		{"atx level 3", "### Alt başlıq\r\n\r\nMətn.", []string{"Heading3:### Alt başlıq\r\n\r\n", "Paragraph:Mətn."}},
+		{"hashtag is not heading", "#Bakı gözəldir", []string{"Paragraph:#Bakı gözəldir"}},
+		{"seven hashes", "####### Yox", []string{"Paragraph:####### Yox"}},
+		{"setext", "Başlıq\n======\nMətn.", []string{"Heading1:Başlıq\n======\n", "Paragraph:Mətn."}},
+		{"setext level 2", "Başlıq\n---\n\nMətn.", []string{"Heading2:Başlıq\n---\n\n", "Paragraph:Mətn."}},
+		{"heading interrupts paragraph", "Mətn.\n## Bölmə\nDavam.", []string{"Paragraph:Mətn.\n", "Heading2:## Bölmə\n", "Paragraph:Davam."}},
+
+		// -- List items --
+		{"bullets", "Tələblər:\n- pasport\n- ərizə", []string{"Paragraph:Tələblər:\n", "ListItem:- pasport\n", "ListItem:- ərizə"}},
+		{"ordered", "1. Birinci\n2. İkinci\n\nSon.", []string{"ListItem:1. Birinci\n", "ListItem:2. İkinci\n\n", "Paragraph:Son."}},
+		{"paren and letter", "1) bənd\na) yarımbənd\nb) yarımbənd", []string{"ListItem:1) bənd\n", "ListItem:a) yarımbənd\n", "ListItem:b) yarımbənd"}},
+		{"azerbaijani letters", "ç) bir\nə) iki\nğ) üç\nı) dörd\nö) beş\nş) altı\nü) yeddi", []string{"ListItem:ç) bir\n", "ListItem:ə) iki\n", "ListItem:ğ) üç\n", "ListItem:ı) dörd\n", "ListItem:ö) beş\n", "ListItem:ş) altı\n", "ListItem:ü) yeddi"}},
+		{"bullet point", "• bir\n• iki", []string{"ListItem:• bir\n", "ListItem:• iki"}},
+		{"continuation", "- uzun bənd\n  davam edir\n- növbəti", []string{"ListItem:- uzun bənd\n  davam edir\n", "ListItem:- növbəti"}},
+		{"nested", "- bir\n  - iki", []string{"ListItem:- bir\n", "ListItem:  - iki"}},
+		{"ordered not first", "Mətn\n2. davam", []string{"Paragraph:Mətn\n2. davam"}},
+		{"negative number", "-5 dərəcə", []string{"Paragraph:-5 dərəcə"}},
+
+		// -- Code blocks --
+		{"fenced", "Kod:\n```go\nx := 1\n\ny := 2\n```\nSon.", []string{"Paragraph:Kod:\n", "CodeBlock:```go\nx := 1\n\ny := 2\n```\n", "Paragraph:Son."}},
+		{"tilde fence", "~~~\n# şərh\n~~~", []string{"CodeBlock:~~~\n# şərh\n~~~"}},
+		{"unclosed fence", "```\nkod\n\nyenə kod", []string{"CodeBlock:```\nkod\n\nyenə kod"}},
+		{"short closer", "````\nkod\n```\n````\nSon.", []string{"CodeBlock:````\nkod\n```\n````\n", "Paragraph:Son."}},
+		{"inline backticks", "```kod``` mətn", []string{"Paragraph:```kod``` mətn"}},
+
+		// -- Tables --
+		{"table", "| Ad | Yaş |\n|----|:---:|\n| Aysel | 25 |\n\nSon.", []string{"Table:| Ad | Yaş |\n|----|:---:|\n| Aysel | 25 |\n\n", "Paragraph:Son."}},
+		{"table without outer pipes", "Ad | Yaş\n--- | ---\nAysel | 25\nSon.", []string{"Table:Ad | Yaş\n--- | ---\nAysel | 25\n", "Paragraph:Son."}},
+		{"table interrupts paragraph", "Cədvəl:\n| a |\n| - |", []string{"Paragraph:Cədvəl:\n", "Table:| a |\n| - |"}},
+		{"pipe without delimiter", "a | b\nc | d", []string{"Paragraph:a | b\nc | d"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := DocumentTokens(tt.input)
+			verifyInvariants(t, tt.input, tokens)
+			got := blockStrings(tokens)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("DocumentTokens(%q) =\n%q\nwant\n%q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDocumentTokensEmpty(t *testing.T) {
+	if got := DocumentTokens(""); got != nil {
+		t.Errorf("DocumentTokens(\"\") = %v, want nil", got)
+	}
+	if got := Blocks(""); got != nil {
+		t.Errorf("Blocks(\"\") = %v, want nil", got)
+	}
+}
+
+func TestSentenceTokensCRLFParagraphs(t *testing.T) {
+	got := Sentences("Birinci sətir\r\n\r\nikinci abzas\n \nüçüncü")
+	want := []string{"Birinci sətir\r\n\r\n", "ikinci abzas\n \n", "üçüncü"}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Sentences = %q, want %q", got, want)
+	}
+}
+
+func TestSentencesBreakAtBlocks(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"heading", "# Giriş\nBu mətndir. Sonra.", []string{"# Giriş\n", "Bu mətndir.", " Sonra."}},
+		{"setext heading", "Başlıq\n======\nMətn.", []string{"Başlıq\n======\n", "Mətn."}},
+		{"list items", "- birinci bənd\n- ikinci bənd\n", []string{"- birinci bənd\n", "- ikinci bənd\n"}},
+		{"list after sentence", "Bəndlər:\n1. bir\n2. iki", []string{"Bəndlər:\n", "1. bir\n", "2. iki"}},
+		{"table", "Cədvəl\n| a | b |\n|---|---|\n| 1 | 2 |\nSonra mətn.", []string{"Cədvəl\n", "| a | b |\n|---|---|\n| 1 | 2 |\n", "Sonra mətn."}},
+		{"code", "Kod:\n```\nx := 1\n```\nSon.", []string{"Kod:\n", "```\nx := 1\n```\n", "Son."}},
+		{"quote closed by block", "O dedi: «gəl\n- bənd. Bir.", []string{"O dedi: «gəl\n", "- bənd.", " Bir."}},
+		{"wrapped number stays", "Bir. İki\n2. ildə", []string{"Bir.", " İki\n2. ildə"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Sentences(tt.input)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Sentences(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func BenchmarkDocumentTokens(b *testing.B) {
+	input := "# Başlıq\n\nProf. Əliyev gəldi. Bakı gözəldir.\n\n- bir\n- iki\n\n| a | b |\n|---|---|\n| 1 | 2 |\n\n```\nkod\n```\n\n"
+	for range 9 {
+		input += input
+	}
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		DocumentTokens(input)
+	}
+}
+
+func ExampleDocumentTokens() {
+	doc := "# Giriş\r\n\r\nMətn burada.\r\n- bir\r\n- iki\r\n"
+	for _, t := range DocumentTokens(doc) {
+		fmt.Printf("%s %d:%d\n", t.Type, t.Start, t.End)
+	}
+	// Output:
+	// Heading 0:12
+	// Paragraph 12:27
+	// ListItem 27:34
+	// ListItem 34:41
+}
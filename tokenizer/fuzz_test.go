@@ -1,6 +1,13 @@
 package tokenizer
 
-import "testing"
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// quantityTokenizer tokenizes in quantities mode.
+var quantityTokenizer = New(Options{Quantities: true})
 
 func FuzzWordTokens(f *testing.F) {
 	f.Add("Salam, d\u00fcnya!")
@@ -11,9 +18,14 @@ func FuzzWordTokens(f *testing.F) {
 	f.Add("\xff\xfe")
 	f.Add("h h h h h h h h")
 	f.Add(".user@domain.com")
+	f.Add("#Bak\u0131 @aysel :(( www.gov.az +994 50 123 45 67 \U0001F44D\U0001F3FD")
+	f.Add("\u20bc25 100 AZN 15% %15 3-c\u00fc 10 km/saat 50 q\u0259pik")
+	f.Add("12.5% -5\u00b0C 100 AZN-d\u0259n 15%-i 1.2.5%")
 	f.Fuzz(func(t *testing.T, s string) {
 		tokens := WordTokens(s)
 		verifyInvariants(t, s, tokens)
+		verifyInvariants(t, s, socialTokenizer.WordTokens(s))
+		verifyInvariants(t, s, quantityTokenizer.WordTokens(s))
 	})
 }
 
@@ -23,8 +35,58 @@ func FuzzSentenceTokens(f *testing.F) {
 	f.Add("Ola bil\u0259r... B\u0259lk\u0259.")
 	f.Add("")
 	f.Add("Az.R. qanunu.")
+	f.Add("O dedi: \u00abG\u0259l. Get.\u00bb Sonra (Bax. \u201eA.\u201c) \"B.\" Son.")
 	f.Fuzz(func(t *testing.T, s string) {
 		tokens := SentenceTokens(s)
 		verifyInvariants(t, s, tokens)
 	})
 }
+
+func FuzzDocumentTokens(f *testing.F) {
+	f.Add("# Ba\u015fl\u0131q\n\nM\u0259tn.\n- bir\n- iki\n")
+	f.Add("Ba\u015fl\u0131q\r\n===\r\n\r\n1) b\u0259nd\r\na) yar\u0131mb\u0259nd")
+	f.Add("```go\nx := 1\n```\n| a | b |\n|---|:-:|\n| 1 | 2 |")
+	f.Add("")
+	f.Add(" \n\t\n ")
+	f.Fuzz(func(t *testing.T, s string) {
+		tokens := DocumentTokens(s)
+		verifyInvariants(t, s, tokens)
+	})
+}
+
+func FuzzDetokenizeTokens(f *testing.F) {
+	f.Add("Salam,  d\u00fcnya!\nBak\u0131'n\u0131n\tk\u00fc\u00e7\u0259l\u0259ri.")
+	f.Add("O dedi: \u00abG\u0259l.\u00bb \"A\" (B) 10:30 3-c\u00fc")
+	f.Add("")
+	f.Fuzz(func(t *testing.T, s string) {
+		if got := DetokenizeTokens(WordTokens(s)); got != s {
+			t.Errorf("DetokenizeTokens(WordTokens(%q)) = %q", s, got)
+		}
+		Detokenize(Words(s))
+	})
+}
+
+func FuzzScanner(f *testing.F) {
+	f.Add("Salam, dünya! https://gov.az user@mail.az", 3)
+	f.Add("1.000.000,50 manat", 1)
+	f.Add("Birinci. İkinci.\n\nSon", 5)
+	f.Add("# Giriş. Bir\n- bənd. İki\n| a. B | c |\n|---|---|\n```\nKod. Bir\n```\nSon.", 2)
+	f.Fuzz(func(t *testing.T, s string, size int) {
+		size = 1 + size&63
+		scanners := []*Scanner{NewScanner(strings.NewReader(s)), NewSentenceScanner(strings.NewReader(s))}
+		wants := [][]Token{WordTokens(s), SentenceTokens(s)}
+		for i, sc := range scanners {
+			sc.Buffer(make([]byte, size), len(s)+size)
+			var got []Token
+			for sc.Scan() {
+				got = append(got, sc.Token())
+			}
+			if sc.Err() != nil {
+				t.Fatalf("Err() = %v", sc.Err())
+			}
+			if !slices.Equal(got, wants[i]) {
+				t.Errorf("buffer %d: got %v, want %v", size, got, wants[i])
+			}
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package tokenizer
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// checkpointInterval is the number of bytes between the rune and UTF-16
+// counts an OffsetMap records, bounding the bytes decoded per conversion.
+const checkpointInterval = 64
+
+// Position is a location in a text in the coordinate systems used by
+// editors and JavaScript front-ends.
+type Position struct {
+	Offset      int `json:"offset"`       // Byte offset
+	Rune        int `json:"rune"`         // Rune (code point) offset
+	UTF16       int `json:"utf16"`        // UTF-16 code unit offset, as in JavaScript strings
+	Line        int `json:"line"`         // Line number, starting at 1
+	Column      int `json:"column"`       // Rune column within the line, starting at 1
+	UTF16Column int `json:"utf16_column"` // UTF-16 column within the line, starting at 1
+}
+
+// checkpoint records the rune and UTF-16 offsets at a rune boundary.
+type checkpoint struct {
+	offset, rune, utf16 int
+}
+
+// OffsetMap converts byte offsets in one text into rune offsets, UTF-16
+// code unit offsets, and line and column positions. It accepts the byte
+// offsets of any result in this module: Token, ner.Entity, datetime.Result,
+// chunker.Chunk, and validate.Issue all index the text they were produced
+// from.
+//
+// An OffsetMap is built once per text in linear time; each conversion then
+// decodes at most a few dozen bytes. Lines end at "\n", so a "\r\n" line
+// ending counts as one break. Each byte of invalid UTF-8 counts as one rune
+// and one UTF-16 code unit, as when ranging over a string. An OffsetMap is
+// immutable and safe for concurrent use.
+type OffsetMap struct {
+	s           string
+	lines       []int        // byte offset of the start of each line
+	checkpoints []checkpoint // the first rune boundary at or after each multiple of checkpointInterval
+	ascii       bool         // byte, rune, and UTF-16 offsets coincide
+}
+
+// NewOffsetMap returns an OffsetMap for s.
+func NewOffsetMap(s string) *OffsetMap {
+	m := &OffsetMap{s: s, lines: []int{0}, ascii: true}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			m.lines = append(m.lines, i+1)
+		}
+		if s[i] >= utf8.RuneSelf {
+			m.ascii = false
+		}
+	}
+	if m.ascii {
+		return m
+	}
+
+	m.checkpoints = make([]checkpoint, 0, len(s)/checkpointInterval+1)
+	runes, units := 0, 0
+	for i, r := range s {
+		if i >= len(m.checkpoints)*checkpointInterval {
+			m.checkpoints = append(m.checkpoints, checkpoint{offset: i, rune: runes, utf16: units})
+		}
+		runes++
+		units += utf16Len(r)
+	}
+	return m
+}
+
+// utf16Len returns the number of UTF-16 code units that encode r.
+func utf16Len(r rune) int {
+	if r >= 0x10000 {
+		return 2
+	}
+	return 1
+}
+
+// Rune returns the rune offset of byte offset off. An offset inside a
+// multi-byte rune maps to the start of that rune; offsets outside the text
+// are clamped to it.
+func (m *OffsetMap) Rune(off int) int {
+	r, _ := m.convert(off)
+	return r
+}
+
+// UTF16 returns the UTF-16 code unit offset of byte offset off, the index
+// a JavaScript string uses. Offsets are clamped and aligned as in Rune.
+func (m *OffsetMap) UTF16(off int) int {
+	_, u := m.convert(off)
+	return u
+}
+
+// Position returns byte offset off in every coordinate system. Offsets are
+// clamped and aligned as in Rune. The Offset field holds the aligned byte
+// offset.
+func (m *OffsetMap) Position(off int) Position {
+	off = m.align(off)
+	line := sort.SearchInts(m.lines, off+1) // lines[line-1] <= off
+	lineStart := m.lines[line-1]
+	r, u := m.convert(off)
+	lr, lu := m.convert(lineStart)
+	return Position{
+		Offset:      off,
+		Rune:        r,
+		UTF16:       u,
+		Line:        line,
+		Column:      r - lr + 1,
+		UTF16Column: u - lu + 1,
+	}
+}
+
+// Span returns the positions of the byte range [start, end), such as the
+// Start and End of a Token or an ner.Entity.
+func (m *OffsetMap) Span(start, end int) (Position, Position) {
+	return m.Position(start), m.Position(end)
+}
+
+// align clamps off to the text and moves it back to the start of the rune
+// that contains it.
+func (m *OffsetMap) align(off int) int {
+	off = max(0, min(off, len(m.s)))
+	if m.ascii {
+		return off
+	}
+	cp := m.checkpointBefore(off)
+	i := cp.offset
+	for i < off {
+		_, size := utf8.DecodeRuneInString(m.s[i:])
+		if i+size > off {
+			break
+		}
+		i += size
+	}
+	return i
+}
+
+// convert returns the rune and UTF-16 offsets of byte offset off.
+func (m *OffsetMap) convert(off int) (runes, units int) {
+	off = max(0, min(off, len(m.s)))
+	if m.ascii {
+		return off, off
+	}
+	cp := m.checkpointBefore(off)
+	i, runes, units := cp.offset, cp.rune, cp.utf16
+	for i < off {
+		r, size := utf8.DecodeRuneInString(m.s[i:])
+		if i+size > off {
+			break
+		}
+		i += size
+		runes++
+		units += utf16Len(r)
+	}
+	return runes, units
+}
+
+// checkpointBefore returns the last checkpoint at or before off.
+func (m *OffsetMap) checkpointBefore(off int) checkpoint {
+	k := min(off/checkpointInterval, len(m.checkpoints)-1)
+	if m.checkpoints[k].offset > off {
+		k--
+	}
+	return m.checkpoints[k]
+}
@@ -0,0 +1,120 @@
+package tokenizer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+func TestOffsetMapPosition(t *testing.T) {
+	// "Şəki 😀\r\nƏli" — Ş and ə are 2 bytes, 😀 is 4 bytes and 2 UTF-16 units.
+	input := "Şəki \U0001F600\r\nƏli"
+	tests := []struct {
+		off  int
+		want Position
+	}{
+		{0, Position{Offset: 0, Rune: 0, UTF16: 0, Line: 1, Column: 1, UTF16Column: 1}},
+		{2, Position{Offset: 2, Rune: 1, UTF16: 1, Line: 1, Column: 2, UTF16Column: 2}},
+		{7, Position{Offset: 7, Rune: 5, UTF16: 5, Line: 1, Column: 6, UTF16Column: 6}},
+		{9, Position{Offset: 7, Rune: 5, UTF16: 5, Line: 1, Column: 6, UTF16Column: 6}}, // inside 😀
+		{11, Position{Offset: 11, Rune: 6, UTF16: 7, Line: 1, Column: 7, UTF16Column: 8}},
+		{13, Position{Offset: 13, Rune: 8, UTF16: 9, Line: 2, Column: 1, UTF16Column: 1}},
+		{15, Position{Offset: 15, Rune: 9, UTF16: 10, Line: 2, Column: 2, UTF16Column: 2}},
+		{17, Position{Offset: 17, Rune: 11, UTF16: 12, Line: 2, Column: 4, UTF16Column: 4}},
+		{-5, Position{Offset: 0, Rune: 0, UTF16: 0, Line: 1, Column: 1, UTF16Column: 1}},
+		{99, Position{Offset: 17, Rune: 11, UTF16: 12, Line: 2, Column: 4, UTF16Column: 4}},
+	}
+	m := NewOffsetMap(input)
+	for _, tt := range tests {
+		if got := m.Position(tt.off); got != tt.want {
+			t.Errorf("Position(%d) = %+v, want %+v", tt.off, got, tt.want)
+		}
+	}
+}
+
+func TestOffsetMapASCII(t *testing.T) {
+	m := NewOffsetMap("ab\ncd\n")
+	if got, want := m.Position(4), (Position{Offset: 4, Rune: 4, UTF16: 4, Line: 2, Column: 2, UTF16Column: 2}); got != want {
+		t.Errorf("Position(4) = %+v, want %+v", got, want)
+	}
+	if got, want := m.Position(6), (Position{Offset: 6, Rune: 6, UTF16: 6, Line: 3, Column: 1, UTF16Column: 1}); got != want {
+		t.Errorf("Position(6) = %+v, want %+v", got, want)
+	}
+}
+
+func TestOffsetMapEmpty(t *testing.T) {
+	m := NewOffsetMap("")
+	if got, want := m.Position(0), (Position{Line: 1, Column: 1, UTF16Column: 1}); got != want {
+		t.Errorf("Position(0) = %+v, want %+v", got, want)
+	}
+}
+
+// TestOffsetMapMatchesDecoding checks every byte offset of a text longer
+// than several checkpoint intervals against a direct count.
+func TestOffsetMapMatchesDecoding(t *testing.T) {
+	input := strings.Repeat("Bakı \U0001F1E6\U0001F1FF gözəldir!\n\xff中文 ", 20)
+	m := NewOffsetMap(input)
+	for off := 0; off <= len(input); off++ {
+		if off < len(input) && !utf8.RuneStart(input[off]) {
+			continue
+		}
+		prefix := input[:off]
+		wantRune := utf8.RuneCountInString(prefix)
+		wantUTF16 := len(utf16.Encode([]rune(prefix)))
+		if got := m.Rune(off); got != wantRune {
+			t.Fatalf("Rune(%d) = %d, want %d", off, got, wantRune)
+		}
+		if got := m.UTF16(off); got != wantUTF16 {
+			t.Fatalf("UTF16(%d) = %d, want %d", off, got, wantUTF16)
+		}
+		lineStart := strings.LastIndexByte(prefix, '\n') + 1
+		p := m.Position(off)
+		wantLine := strings.Count(prefix, "\n") + 1
+		wantColumn := utf8.RuneCountInString(prefix[lineStart:]) + 1
+		if p.Line != wantLine || p.Column != wantColumn {
+			t.Fatalf("Position(%d) line:column = %d:%d, want %d:%d", off, p.Line, p.Column, wantLine, wantColumn)
+		}
+	}
+}
+
+func TestOffsetMapSpan(t *testing.T) {
+	input := "Salam, Əli! Bakı gözəldir."
+	m := NewOffsetMap(input)
+	for _, tok := range WordTokens(input) {
+		start, end := m.Span(tok.Start, tok.End)
+		runes := []rune(input)
+		if got := string(runes[start.Rune:end.Rune]); got != tok.Text {
+			t.Errorf("runes[%d:%d] = %q, want %q", start.Rune, end.Rune, got, tok.Text)
+		}
+	}
+}
+
+func BenchmarkOffsetMap(b *testing.B) {
+	input := strings.Repeat("Prof. Ǝliyev 1.000 manat ödədi. \U0001F600\n", 1000)
+	tokens := WordTokens(input)
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		m := NewOffsetMap(input)
+		for _, t := range tokens {
+			m.Span(t.Start, t.End)
+		}
+	}
+}
+
+func ExampleOffsetMap() {
+	text := "Salam \U0001F600\nƏli gəldi."
+	m := NewOffsetMap(text)
+	for _, t := range WordTokens(text) {
+		if t.Type == Word {
+			p := m.Position(t.Start)
+			fmt.Printf("%s byte %d, rune %d, utf16 %d, %d:%d\n", t.Text, t.Start, p.Rune, p.UTF16, p.Line, p.Column)
+		}
+	}
+	// Output:
+	// Salam byte 0, rune 0, utf16 0, 1:1
+	// Əli byte 11, rune 8, utf16 9, 2:1
+	// gəldi byte 16, rune 12, utf16 13, 2:5
+}
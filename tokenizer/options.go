@@ -0,0 +1,169 @@
+package tokenizer
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
+)
+
+// Options configures a Tokenizer.
+type Options struct {
+	// Abbreviations adds abbreviations whose period never ends a sentence,
+	// matched case-insensitively: "məs.", "bax.", "səh.", or single letters
+	// such as "m.", "s.", "c." that the built-in list leaves out. The
+	// trailing period is optional. Multi-part forms ("b.e.ə.") are matched
+	// as a whole, up to four parts.
+	Abbreviations []string
+
+	// NonBreakingPrefixes adds words after which a period never ends a
+	// sentence, matched exactly as written. Use them for forms that are
+	// abbreviations in one case only, such as the initials in
+	// "M. Ə. Rəsulzadə", without keeping a sentence-final "m." unbroken.
+	NonBreakingPrefixes []string
+
+	// Protected lists patterns whose matches are never split. WordTokens
+	// merges the tokens a match overlaps into one token, typed as the first
+	// of them, and SentenceTokens makes no break inside a match.
+	Protected []*regexp.Regexp
+
+	// Social enables the social-media token types in WordTokens and Words:
+	// Hashtag, Mention, Emoji (a whole ZWJ sequence, with skin-tone
+	// modifiers, as one token), Emoticon, Phone, and URL for bare domains
+	// such as www.example.com or gov.az/news.
+	Social bool
+
+	// Quantities enables the compound quantity token types in WordTokens:
+	// Money (100 AZN, ₼25), Percent (15%), Ordinal (3-cü, 2024-cü), and
+	// Measurement (10 km/saat). Each carries its parsed amount in
+	// Token.Value and its currency code, "%", or unit in Token.Unit. A
+	// minus sign and a hyphenated case suffix belong to the token (-5°C,
+	// 100 AZN-dən, 15%-i).
+	Quantities bool
+}
+
+// Tokenizer is a tokenizer with user abbreviations, protected patterns, and
+// optional social-media and quantity token types layered over the built-in
+// rules. A Tokenizer is immutable after
+// construction and safe for concurrent use. The zero Tokenizer behaves like
+// the package-level functions.
+type Tokenizer struct {
+	abbreviations map[string]bool // lowercase, with trailing dot
+	prefixes      map[string]bool // as written, with trailing dot
+	protected     []*regexp.Regexp
+	social        bool
+	quantities    bool
+}
+
+// defaultTokenizer backs the package-level functions.
+var defaultTokenizer = &Tokenizer{}
+
+// New returns a Tokenizer configured by opts. Empty entries and nil
+// patterns are ignored.
+func New(opts Options) *Tokenizer {
+	tk := &Tokenizer{
+		abbreviations: make(map[string]bool, len(opts.Abbreviations)),
+		prefixes:      make(map[string]bool, len(opts.NonBreakingPrefixes)),
+		social:        opts.Social,
+		quantities:    opts.Quantities,
+	}
+	for _, a := range opts.Abbreviations {
+		if a = abbreviationKey(a); a != "" {
+			tk.abbreviations[azcase.ToLower(a)] = true
+		}
+	}
+	for _, p := range opts.NonBreakingPrefixes {
+		if p = abbreviationKey(p); p != "" {
+			tk.prefixes[p] = true
+		}
+	}
+	for _, re := range opts.Protected {
+		if re != nil {
+			tk.protected = append(tk.protected, re)
+		}
+	}
+	return tk
+}
+
+// abbreviationKey normalizes an abbreviation to NFC with a trailing dot.
+// It returns "" for an empty entry.
+func abbreviationKey(a string) string {
+	a = azcase.ComposeNFC(strings.TrimSpace(a))
+	if strings.Trim(a, ".") == "" {
+		return ""
+	}
+	if !strings.HasSuffix(a, ".") {
+		a += "."
+	}
+	return a
+}
+
+// isAbbreviationText reports whether text, a dotted chain ending in a dot,
+// is a built-in or user abbreviation or a non-breaking prefix.
+func (tk *Tokenizer) isAbbreviationText(text string) bool {
+	if tk.prefixes[text] {
+		return true
+	}
+	lower := azcase.ToLower(text)
+	return abbreviations[lower] || tk.abbreviations[lower]
+}
+
+// protectedSpans returns the [start, end) byte ranges of s matched by the
+// protected patterns, sorted and with overlapping ranges merged.
+func (tk *Tokenizer) protectedSpans(s string) [][]int {
+	if len(tk.protected) == 0 {
+		return nil
+	}
+	var spans [][]int
+	for _, re := range tk.protected {
+		for _, m := range re.FindAllStringIndex(s, -1) {
+			if m[1] > m[0] {
+				spans = append(spans, m)
+			}
+		}
+	}
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+	merged := spans[:0]
+	for _, sp := range spans {
+		if n := len(merged); n > 0 && sp[0] < merged[n-1][1] {
+			merged[n-1][1] = max(merged[n-1][1], sp[1])
+			continue
+		}
+		merged = append(merged, sp)
+	}
+	return merged
+}
+
+// insideSpan reports whether pos falls strictly inside one of spans.
+func insideSpan(spans [][]int, pos int) bool {
+	for _, sp := range spans {
+		if sp[0] < pos && pos < sp[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// protectTokens merges the tokens overlapping each span into one token
+// typed as the first of them. Spans must be sorted and disjoint.
+func protectTokens(s string, tokens []Token, spans [][]int) []Token {
+	if len(spans) == 0 {
+		return tokens
+	}
+	out := tokens[:0]
+	k := 0
+	for _, t := range tokens {
+		for k < len(spans) && spans[k][1] <= t.Start {
+			k++
+		}
+		if n := len(out); n > 0 && k < len(spans) && t.Start < spans[k][1] && t.End > spans[k][0] &&
+			out[n-1].End > spans[k][0] {
+			out[n-1].End = t.End
+			out[n-1].Text = s[out[n-1].Start:t.End]
+			continue
+		}
+		out = append(out, t)
+	}
+	return out
+}
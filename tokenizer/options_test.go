@@ -0,0 +1,99 @@
+package tokenizer
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestTokenizerAbbreviations(t *testing.T) {
+	tk := New(Options{
+		Abbreviations:       []string{"m.", "s", "məs.", " bax. ", "c.", "SƏH.", "b.e.ə."},
+		NonBreakingPrefixes: []string{"M", "Ə."},
+	})
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{"Qanunun 5-ci m. Tətbiq edilir.", []string{"Qanunun 5-ci m. Tətbiq edilir."}},
+		{"Bax. Cədvəl 3.", []string{"Bax. Cədvəl 3."}},
+		{"I c. Səh. 12-yə bax.", []string{"I c. Səh. 12-yə bax."}},
+		{"Məs. Bakı şəhəri.", []string{"Məs. Bakı şəhəri."}},
+		{"IV əsr b.e.ə. Roma.", []string{"IV əsr b.e.ə. Roma."}},
+		{"M. Ə. Rəsulzadə yazdı.", []string{"M. Ə. Rəsulzadə yazdı."}},
+
+		// -- Built-in rules still apply --
+		{"Prof. Əliyev gəldi. Sonra getdi.", []string{"Prof. Əliyev gəldi.", " Sonra getdi."}},
+
+		// -- Non-breaking prefixes are case-sensitive --
+		{"Heyət ə. Sonra", []string{"Heyət ə.", " Sonra"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := tk.Sentences(tt.input)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Sentences(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+			verifyInvariants(t, tt.input, tk.SentenceTokens(tt.input))
+		})
+	}
+
+	// The package-level functions do not see user abbreviations.
+	if got := Sentences("Bax. Cədvəl 3."); len(got) != 2 {
+		t.Errorf("package Sentences split %q, want the built-in rules", got)
+	}
+}
+
+func TestTokenizerProtected(t *testing.T) {
+	tk := New(Options{Protected: []*regexp.Regexp{
+		regexp.MustCompile(`№5\d*-[IVX]+`),
+		regexp.MustCompile(`[A-Z]\. [A-Z]\. [A-Z]\w+`),
+		nil,
+	}})
+
+	input := "Qanun №5-IV. Qüvvəyə minir."
+	want := []Token{
+		{Text: "Qanun", Start: 0, End: 5, Type: Word},
+		{Text: " ", Start: 5, End: 6, Type: Space},
+		{Text: "№5-IV", Start: 6, End: 13, Type: Symbol},
+		{Text: ".", Start: 13, End: 14, Type: Punctuation},
+	}
+	got := tk.WordTokens(input)
+	if !slices.Equal(got[:len(want)], want) {
+		t.Errorf("WordTokens(%q) = %v, want prefix %v", input, got, want)
+	}
+	verifyInvariants(t, input, got)
+
+	input = "Yazan: J. R. Tolkien. Kitab."
+	if got, want := tk.Sentences(input), []string{"Yazan: J. R. Tolkien.", " Kitab."}; !slices.Equal(got, want) {
+		t.Errorf("Sentences(%q) = %q, want %q", input, got, want)
+	}
+	if got := tk.Words(input); !slices.Contains(got, "J. R. Tolkien") {
+		t.Errorf("Words(%q) = %q, want the protected name as one word", input, got)
+	}
+}
+
+func TestZeroTokenizer(t *testing.T) {
+	var tk Tokenizer
+	input := "Prof. Ǝliyev 1.000 manat ödədi. user@mail.az https://gov.az"
+	if !slices.Equal(tk.WordTokens(input), WordTokens(input)) {
+		t.Error("zero Tokenizer WordTokens differs from the package function")
+	}
+	if !slices.Equal(tk.Sentences(input), Sentences(input)) {
+		t.Error("zero Tokenizer Sentences differs from the package function")
+	}
+	if tk.Words("") != nil || tk.SentenceTokens("") != nil {
+		t.Error("zero Tokenizer returned non-nil for empty input")
+	}
+}
+
+func ExampleNew() {
+	tk := New(Options{Abbreviations: []string{"məs.", "səh."}})
+	for _, s := range tk.Sentences("Məs. Bakı şəhəri. Sonra Səh. 5.") {
+		fmt.Printf("%q\n", s)
+	}
+	// Output:
+	// "Məs. Bakı şəhəri."
+	// " Sonra Səh. 5."
+}
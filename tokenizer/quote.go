@@ -0,0 +1,109 @@
+package tokenizer
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// maxQuoteDepth bounds the nesting tracked by quoteStack; deeper openers
+// are ignored.
+const maxQuoteDepth = 16
+
+// quoteStack tracks the quotes and brackets open at a position of the
+// text, innermost last. Sentence splitting makes no break while any is open.
+type quoteStack []rune
+
+// isQuoteOrBracket reports whether r opens or closes a quotation or a
+// bracketed aside.
+func isQuoteOrBracket(r rune) bool {
+	return isOpener(r) || isCloser(r)
+}
+
+// isOpener reports whether r can open a quotation or bracket.
+// The straight quote " and “ can also close one.
+func isOpener(r rune) bool {
+	switch r {
+	case '«', '„', '“', '‹', '(', '[', '"':
+		return true
+	}
+	return false
+}
+
+// isCloser reports whether r can close a quotation or bracket.
+func isCloser(r rune) bool {
+	switch r {
+	case '»', '“', '”', '›', ')', ']', '"':
+		return true
+	}
+	return false
+}
+
+// closes reports whether r closes the quotation or bracket opened by open.
+// „ is closed by “ (Azerbaijani and Russian style) or ”.
+func closes(open, r rune) bool {
+	switch open {
+	case '«':
+		return r == '»'
+	case '„':
+		return r == '“' || r == '”'
+	case '“':
+		return r == '”'
+	case '‹':
+		return r == '›'
+	case '(':
+		return r == ')'
+	case '[':
+		return r == ']'
+	case '"':
+		return r == '"'
+	}
+	return false
+}
+
+// feed updates the stack with the quote or bracket r, preceded by prev
+// (ignored if atStart). A rune that closes the innermost opener pops it;
+// an unambiguous closer also pops a deeper match, recovering from an
+// unclosed inner quote. A straight quote opens only at the start of a word,
+// so that 5" or a stray closing quote leaves the stack unchanged.
+func (q *quoteStack) feed(r, prev rune, atStart bool) {
+	st := *q
+	if n := len(st); n > 0 && closes(st[n-1], r) {
+		*q = st[:n-1]
+		return
+	}
+	if !isOpener(r) {
+		for k := len(st) - 2; k >= 0; k-- {
+			if closes(st[k], r) {
+				*q = st[:k]
+				return
+			}
+		}
+		return
+	}
+	if r == '"' && !atStart && !unicode.IsSpace(prev) && !isOpener(prev) {
+		return
+	}
+	if len(st) < maxQuoteDepth {
+		*q = append(st, r)
+	}
+}
+
+// closeAfter consumes the closing quotes and brackets at pos that follow
+// terminal punctuation («Gəlirəm.» or (Bax.)), popping the ones that match
+// the stack, and returns the position after them. Stray closers are
+// consumed only when nothing is open.
+func (q *quoteStack) closeAfter(s string, pos int) int {
+	for pos < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		st := *q
+		switch {
+		case len(st) > 0 && closes(st[len(st)-1], r):
+			*q = st[:len(st)-1]
+		case len(st) == 0 && isCloser(r) && r != '"' && r != '“':
+		default:
+			return pos
+		}
+		pos += size
+	}
+	return pos
+}
@@ -1,9 +1,12 @@
 package tokenizer
 
 import (
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/az-ai-labs/az-lang-nlp/azcase"
 )
 
 // wordTokens splits s into tokens using a rune-by-rune state machine.
@@ -12,11 +15,13 @@ import (
 // Rule priority (highest first):
 //   - URL detection (http:// or https://)
 //   - Email detection (backtrack from @)
+//   - Social-media tokens, in social mode (see scanSocial)
+//   - Money, Percent, Ordinal, and Measurement, in quantities mode (see scanQuantity)
 //   - Number grouping (dot as thousand separator, comma as decimal)
 //   - Hyphen joining (single U+002D between letter/digit)
 //   - Apostrophe joining (U+0027, U+2019, U+02BC between letters)
 //   - Default unicode classification
-func wordTokens(s string) []Token {
+func (tk *Tokenizer) wordTokens(s string) []Token {
 	tokens := make([]Token, 0, len(s)/4+1)
 
 	i := 0
@@ -43,6 +48,24 @@ func wordTokens(s string) []Token {
 			}
 		}
 
+		// Social mode: hashtags, mentions, emoji, emoticons, bare URLs, phones
+		if tk.social {
+			if tok, ok := scanSocial(s, i); ok {
+				tokens = append(tokens, tok)
+				i = tok.End
+				continue
+			}
+		}
+
+		// Quantities mode: 100 AZN, ₼25, 15%, 3-cü, 10 km/saat
+		if tk.quantities {
+			if tok, ok := scanQuantity(s, i); ok {
+				tokens = append(tokens, tok)
+				i = tok.End
+				continue
+			}
+		}
+
 		// Whitespace: merge contiguous into one Space token
 		if unicode.IsSpace(r) {
 			start := i
@@ -248,6 +271,217 @@ func scanNumber(s string, pos int) Token {
 	return Token{Text: s[pos:i], Start: pos, End: i, Type: Number}
 }
 
+// currencySymbols maps currency signs, written before or after the
+// amount, to ISO 4217 codes.
+var currencySymbols = map[rune]string{
+	'₼': "AZN", '$': "USD", '€': "EUR", '£': "GBP", '₽': "RUB", '₺': "TRY",
+}
+
+// currencyCodes lists the ISO 4217 codes recognized after an amount.
+var currencyCodes = map[string]bool{
+	"AZN": true, "USD": true, "EUR": true, "GBP": true, "RUB": true, "TRY": true,
+}
+
+// currencyNames maps currency names written after the amount to ISO 4217
+// codes. Names may carry case suffixes (manatdan, dollarlıq).
+var currencyNames = []struct{ name, code string }{
+	{"manat", "AZN"}, {"qəpik", "AZN"}, {"dollar", "USD"}, {"avro", "EUR"},
+	{"funt", "GBP"}, {"rubl", "RUB"}, {"lirə", "TRY"},
+}
+
+// measureUnits lists the units recognized after an amount, as written.
+// A unit may also be a ratio of two of them (km/saat, kVt/saat).
+var measureUnits = map[string]bool{
+	"km": true, "m": true, "sm": true, "mm": true, "km²": true, "m²": true, "sm²": true, "m³": true,
+	"kq": true, "q": true, "mq": true, "t": true, "l": true, "ml": true, "ha": true,
+	"Vt": true, "kVt": true, "MVt": true, "°C": true, "°": true,
+	"KB": true, "MB": true, "GB": true, "TB": true,
+	"saat": true, "san": true, "dəq": true, "s": true,
+}
+
+// ordinalSuffixes lists the ordinal suffixes that follow a hyphen after
+// digits (3-cü, 5-inci), before any case ending (2024-cü, 1-cidən).
+var ordinalSuffixes = []string{"ıncı", "inci", "uncu", "üncü", "cı", "ci", "cu", "cü"}
+
+// scanQuantity reads a quantity starting at position pos: an amount with a
+// currency sign or name (Money), a percentage (Percent), an ordinal
+// numeral (Ordinal), or an amount with a unit of measure (Measurement).
+// The token's Value holds the amount, negative after a minus sign (-5°C),
+// and Unit the currency code, "%", or the unit as written; qəpik amounts
+// are converted to manat. A case suffix after a hyphen is part of the
+// token (100 AZN-dən, 15%-i, 3-cüsü).
+func scanQuantity(s string, pos int) (Token, bool) {
+	r, size := utf8.DecodeRuneInString(s[pos:])
+
+	// Minus sign before the amount: -5°C, −3%.
+	if (r == '-' || r == '−') && pos+size < len(s) && isDigitByte(s[pos+size]) && atWordBoundary(s, pos) {
+		tok, ok := scanQuantity(s, pos+size)
+		if !ok || tok.Type == Ordinal {
+			return Token{}, false
+		}
+		return quantityToken(s, pos, tok.End, tok.Type, -tok.Value, tok.Unit), true
+	}
+
+	// Sign before the amount: ₼25, $ 100, %15.
+	if code, ok := currencySymbols[r]; ok || r == '%' {
+		start := pos + size
+		if start < len(s) && s[start] == ' ' && r != '%' {
+			start++
+		}
+		if start >= len(s) || !isDigitByte(s[start]) || !atWordBoundary(s, pos) {
+			return Token{}, false
+		}
+		end, value := scanAmount(s, start)
+		if letterOrDigitAt(s, end) {
+			return Token{}, false
+		}
+		if r == '%' {
+			return quantityToken(s, pos, end, Percent, value, "%"), true
+		}
+		return quantityToken(s, pos, end, Money, value, code), true
+	}
+
+	if !isDigitByte(s[pos]) || !atWordBoundary(s, pos) || continuesNumber(s, pos) {
+		return Token{}, false
+	}
+	i, value := scanAmount(s, pos)
+
+	// Ordinal: 3-cü, 2024-cü, 1-cidən.
+	if i+1 < len(s) && s[i] == '-' && !strings.ContainsAny(s[pos:i], ".,") {
+		for _, suf := range ordinalSuffixes {
+			if strings.HasPrefix(s[i+1:], suf) {
+				end := consumeLetters(s, i+1)
+				return quantityToken(s, pos, end, Ordinal, value, ""), true
+			}
+		}
+	}
+
+	// The sign, name, or unit after the amount, with at most one space.
+	j := i
+	if j < len(s) && s[j] == ' ' {
+		j++
+	}
+	if j >= len(s) {
+		return Token{}, false
+	}
+	r, size = utf8.DecodeRuneInString(s[j:])
+	if r == '%' {
+		return quantityToken(s, pos, j+size, Percent, value, "%"), true
+	}
+	if code, ok := currencySymbols[r]; ok && !letterOrDigitAt(s, j+size) {
+		return quantityToken(s, pos, j+size, Money, value, code), true
+	}
+	if end, code, ok := scanCurrencyWord(s, j); ok {
+		if strings.HasPrefix(azcase.ToLower(s[j:end]), "qəpik") {
+			value /= 100
+		}
+		return quantityToken(s, pos, end, Money, value, code), true
+	}
+	if end, ok := scanUnit(s, j); ok {
+		return quantityToken(s, pos, end, Measurement, value, s[j:end]), true
+	}
+	return Token{}, false
+}
+
+// scanAmount reads the amount of a quantity at pos and returns its end and
+// value. Besides the Number forms (1.000,50), it accepts a decimal point
+// as percentages and rates are often written (12.5%).
+func scanAmount(s string, pos int) (end int, value float64) {
+	num := scanNumber(s, pos)
+	end = num.End
+	if end+1 < len(s) && s[end] == '.' && isDigitByte(s[end+1]) && !strings.ContainsAny(num.Text, ".,") {
+		j := end + 1
+		for j < len(s) && isDigitByte(s[j]) {
+			j++
+		}
+		if v, err := strconv.ParseFloat(s[pos:j], 64); err == nil {
+			return j, v
+		}
+	}
+	return end, parseNumber(num.Text)
+}
+
+// continuesNumber reports whether the digits at pos follow a digit and a
+// dot or comma, as in the 5 of 1.2.5%, so they are not an amount of their
+// own.
+func continuesNumber(s string, pos int) bool {
+	return pos >= 2 && (s[pos-1] == '.' || s[pos-1] == ',') && isDigitByte(s[pos-2])
+}
+
+// quantityToken returns the quantity token s[start:end], extended over a
+// case suffix after a hyphen (AZN-dən, %-i).
+func quantityToken(s string, start, end int, typ TokenType, value float64, unit string) Token {
+	if end+1 < len(s) && s[end] == '-' && startsWithLetter(s[end+1:]) {
+		end = consumeLetters(s, end+1)
+	}
+	return Token{Text: s[start:end], Start: start, End: end, Type: typ, Value: value, Unit: unit}
+}
+
+// scanCurrencyWord reads a currency code or name at pos. Codes must stand
+// alone; names may be followed by suffix letters.
+func scanCurrencyWord(s string, pos int) (end int, code string, ok bool) {
+	end = consumeLetters(s, pos)
+	word := s[pos:end]
+	if currencyCodes[word] {
+		return end, word, true
+	}
+	lower := azcase.ToLower(word)
+	for _, c := range currencyNames {
+		if strings.HasPrefix(lower, c.name) {
+			return end, c.code, true
+		}
+	}
+	return 0, "", false
+}
+
+// scanUnit reads a unit of measure at pos, or a ratio of two units, which
+// must not run into a letter or digit.
+func scanUnit(s string, pos int) (end int, ok bool) {
+	end = pos
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		if !unicode.IsLetter(r) && r != '²' && r != '³' && r != '°' {
+			break
+		}
+		end += size
+	}
+	if !measureUnits[s[pos:end]] {
+		return 0, false
+	}
+	if end+1 < len(s) && s[end] == '/' {
+		if den, ok := scanUnit(s, end+1); ok {
+			return den, true
+		}
+	}
+	if letterOrDigitAt(s, end) {
+		return 0, false
+	}
+	return end, true
+}
+
+// consumeLetters returns the end of the run of letters starting at pos.
+func consumeLetters(s string, pos int) int {
+	for pos < len(s) {
+		r, size := utf8.DecodeRuneInString(s[pos:])
+		if !unicode.IsLetter(r) {
+			break
+		}
+		pos += size
+	}
+	return pos
+}
+
+// parseNumber returns the value of a Number token's text: dots separate
+// thousands and a comma marks the decimals (1.000,50 is 1000.5).
+func parseNumber(text string) float64 {
+	text = strings.ReplaceAll(text, ".", "")
+	v, err := strconv.ParseFloat(strings.Replace(text, ",", ".", 1), 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
 // scanWord reads a word token starting at position pos.
 // A word begins with a letter and may contain digits (e.g. "A4"), single
 // hyphens (U+002D) between letters/digits, and apostrophes (U+0027,
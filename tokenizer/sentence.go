@@ -10,7 +10,6 @@ import (
 
 // abbreviations maps common Azerbaijani abbreviations (lowercase, with trailing dot)
 // to true. Used to suppress false sentence breaks after abbreviated words.
-// "az." is included to support greedy forward matching to "az.r.".
 var abbreviations = map[string]bool{
 	"prof.": true, "dos.": true, "ak.": true, "dr.": true,
 	"az.": true, "az.r.": true, "ar.": true,
@@ -19,32 +18,101 @@ var abbreviations = map[string]bool{
 	"km.": true, "kq.": true, "sm.": true, "min.": true,
 }
 
-// sentenceTokens splits s into sentence-level tokens.
+// maxQuotedBreaks is the number of sentence breaks an open quote or
+// bracket may hold back. At that many, the opener is taken for a stray
+// one, the held-back breaks are made, and the quote is forgotten, so that
+// a stray « or ( does not join the rest of a paragraph, or of a stream
+// without blank lines, into one sentence.
+const maxQuotedBreaks = 4
+
+// maxAbbreviationParts bounds the dotted chain tried as an abbreviation,
+// so that long runs like a.b.c.d... are not rescanned at every dot.
+const maxAbbreviationParts = 4
+
+// sentenceTokens splits s into sentence-level tokens. s continues the
+// block cut from mid-line if cut is not nil.
 // Adjacent tokens cover the entire input without gaps or overlaps:
 // concatenating all Token.Text values reconstructs s exactly.
-func sentenceTokens(s string) []Token {
+// No break is made inside a match of a protected pattern.
+func (tk *Tokenizer) sentenceTokens(s string, cut *cutBlock) []Token {
 	tokens := make([]Token, 0, len(s)/40+1)
 	sentStart := 0 // byte offset where the current sentence begins
+	spans := tk.protectedSpans(s)
+	blocks := blockTokens(s, cut)
+	block := 1 // index of the next block to start
+
+	// split ends the current sentence at end unless end is protected.
+	split := func(end int) {
+		if insideSpan(spans, end) {
+			return
+		}
+		tokens = append(tokens, Token{
+			Text:  s[sentStart:end],
+			Start: sentStart,
+			End:   end,
+			Type:  Sentence,
+		})
+		sentStart = end
+	}
+
+	var q quoteStack // open quotes and brackets; no break while any is open
+	var held []int   // sentence breaks held back by the open quotes
+
+	// boundary makes a sentence break at end if a sentence starts there,
+	// or holds it back while a quote is open.
+	boundary := func(end int) {
+		if !followedBySentenceStart(s, end) {
+			return
+		}
+		if len(q) == 0 {
+			held = held[:0]
+			split(end)
+			return
+		}
+		held = append(held, end)
+		if len(held) == maxQuotedBreaks {
+			for _, b := range held {
+				split(b)
+			}
+			q, held = q[:0], held[:0]
+		}
+	}
 
 	i := 0
 	for i < len(s) {
+		// A Markdown block (heading, list item, table, code) starts a new
+		// sentence, unless only whitespace precedes it in this one, and
+		// closes any quote left open in the block before.
+		if block < len(blocks) && blocks[block].Start <= i {
+			if start := blocks[block].Start; !isBlank(s[sentStart:start]) {
+				split(start)
+			}
+			q, held = q[:0], held[:0]
+			block++
+			continue
+		}
+
 		r, size := utf8.DecodeRuneInString(s[i:])
 
-		// Double newline forces a sentence break regardless of punctuation.
-		if r == '\n' && i+1 < len(s) && s[i+1] == '\n' {
-			// Consume all consecutive newlines as part of the current sentence.
-			j := i
-			for j < len(s) && s[j] == '\n' {
-				j++
+		// A blank line forces a sentence break regardless of punctuation,
+		// and closes any quote left open in the paragraph.
+		if r == '\n' {
+			// Consume all following blank lines as part of the current sentence.
+			if j := blankLinesEnd(s, i+1); j > i+1 {
+				split(j)
+				q, held = q[:0], held[:0]
+				i = j
+				continue
 			}
-			tokens = append(tokens, Token{
-				Text:  s[sentStart:j],
-				Start: sentStart,
-				End:   j,
-				Type:  Sentence,
-			})
-			sentStart = j
-			i = j
+		}
+
+		if isQuoteOrBracket(r) {
+			prev, _ := utf8.DecodeLastRuneInString(s[:i])
+			if len(q) == 0 {
+				held = held[:0]
+			}
+			q.feed(r, prev, i == 0)
+			i += size
 			continue
 		}
 
@@ -57,23 +125,15 @@ func sentenceTokens(s string) []Token {
 				for j < len(s) && s[j] == '.' {
 					j++
 				}
-				if followedByWhitespaceUppercase(s, j) {
-					breakPos := j
-					tokens = append(tokens, Token{
-						Text:  s[sentStart:breakPos],
-						Start: sentStart,
-						End:   breakPos,
-						Type:  Sentence,
-					})
-					sentStart = breakPos
-				}
+				j = q.closeAfter(s, j)
+				boundary(j)
 				i = j
 				continue
 			}
 
 			// Single dot: check for abbreviation.
 			if r == '.' {
-				if isAbbreviation(s, i) {
+				if tk.isAbbreviation(s, i) {
 					i += size
 					continue
 				}
@@ -90,31 +150,16 @@ func sentenceTokens(s string) []Token {
 				}
 			}
 
-			if followedByWhitespaceUppercase(s, j) {
-				tokens = append(tokens, Token{
-					Text:  s[sentStart:j],
-					Start: sentStart,
-					End:   j,
-					Type:  Sentence,
-				})
-				sentStart = j
-			}
+			j = q.closeAfter(s, j)
+			boundary(j)
 			i = j
 			continue
 		}
 
 		// Unicode ellipsis U+2026.
 		if r == '\u2026' {
-			j := i + size
-			if followedByWhitespaceUppercase(s, j) {
-				tokens = append(tokens, Token{
-					Text:  s[sentStart:j],
-					Start: sentStart,
-					End:   j,
-					Type:  Sentence,
-				})
-				sentStart = j
-			}
+			j := q.closeAfter(s, i+size)
+			boundary(j)
 			i = j
 			continue
 		}
@@ -135,91 +180,91 @@ func sentenceTokens(s string) []Token {
 	return tokens
 }
 
-// followedByWhitespaceUppercase reports whether position pos in s is followed
-// by at least one whitespace character and then an uppercase letter.
-func followedByWhitespaceUppercase(s string, pos int) bool {
+// isTerminal reports whether r is sentence-final punctuation.
+func isTerminal(r rune) bool {
+	return r == '.' || r == '?' || r == '!' || r == '\u2026'
+}
+
+// followedBySentenceStart reports whether position pos in s is followed
+// by at least one whitespace character and then an uppercase letter,
+// optionally after opening quotes or brackets («Salam», (Bax).
+func followedBySentenceStart(s string, pos int) bool {
 	i := pos
-	foundSpace := false
 	for i < len(s) {
 		r, size := utf8.DecodeRuneInString(s[i:])
-		if unicode.IsSpace(r) {
-			foundSpace = true
-			i += size
-		} else {
-			return foundSpace && unicode.IsUpper(r)
+		if !unicode.IsSpace(r) {
+			break
 		}
+		i += size
+	}
+	if i == pos {
+		return false
 	}
-	return false
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isOpener(r) {
+			break
+		}
+		i += size
+	}
+	r, _ := utf8.DecodeRuneInString(s[i:])
+	return i < len(s) && unicode.IsUpper(r)
 }
 
 // isAbbreviation checks whether the dot at byte position dotPos is part of
 // a known abbreviation rather than a sentence-ending period.
-// It also handles the special multi-word abbreviation "və s." pattern.
-func isAbbreviation(s string, dotPos int) bool {
+// A multi-part abbreviation (Az.R., e.ə.) is matched as a whole from its last
+// dot. It also handles the special multi-word abbreviation "və s." pattern.
+func (tk *Tokenizer) isAbbreviation(s string, dotPos int) bool {
 	// Extract the word immediately before the dot.
 	word, wordStart := wordBefore(s, dotPos)
 	if word == "" {
 		return false
 	}
 
-	lower := azcase.ToLower(word)
-	candidate := lower + "."
-
 	// Special case: "və s." — if the word is "s" and the previous word is "və",
 	// suppress the sentence break.
-	if lower == "s" {
+	if azcase.ToLower(word) == "s" {
 		prevWord, _ := wordBefore(s, wordStart)
 		if strings.EqualFold(prevWord, "və") {
 			return true
 		}
 	}
 
-	if !abbreviations[candidate] {
-		return false
+	// Try the dotted chain ending at dotPos, longest first: "Az.R." then "R.".
+	start := chainStart(s, wordStart)
+	for {
+		candidate := s[start : dotPos+1]
+		if tk.isAbbreviationText(candidate) {
+			return true
+		}
+		if start == wordStart {
+			return false
+		}
+		start = nextPart(s, start, wordStart)
 	}
-
-	// Greedy forward matching: check if the abbreviation extends further.
-	// For example, after matching "az.", check if what follows forms "az.r.".
-	afterDot := dotPos + 1
-	return greedyAbbreviation(s, candidate, afterDot)
 }
 
-// greedyAbbreviation tries to extend a matched abbreviation prefix forward.
-// It returns true once no further extension is possible, confirming the abbreviation.
-// For example: prefix="az.", pos points to text after the dot.
-// If next chars are "r.", it checks "az.r." — if that is also an abbreviation, recurse.
-func greedyAbbreviation(s, prefix string, pos int) bool {
-	// Try to read the next word and dot to extend the abbreviation.
-	// The next segment must be: word + "." immediately adjacent (no whitespace).
-	if pos >= len(s) {
-		return true // abbreviation at end of input
-	}
-
-	// Read next word characters (letters only, no whitespace allowed).
-	j := pos
-	for j < len(s) {
-		r, size := utf8.DecodeRuneInString(s[j:])
-		if unicode.IsLetter(r) {
-			j += size
-		} else {
+// chainStart returns the start of the dotted chain (b.e, Az.R) whose last
+// word begins at wordStart: up to maxAbbreviationParts runs of letters
+// separated by single dots.
+func chainStart(s string, wordStart int) int {
+	start := wordStart
+	for parts := 1; parts < maxAbbreviationParts && start > 0 && s[start-1] == '.'; parts++ {
+		word, i := wordBefore(s, start-1)
+		if word == "" || i != start-1-len(word) {
 			break
 		}
+		start = i
 	}
+	return start
+}
 
-	if j == pos || j >= len(s) || s[j] != '.' {
-		return true // no extension possible, current match stands
-	}
-
-	// We have a potential extension: prefix + nextWord + "."
-	nextWord := azcase.ToLower(s[pos:j])
-	extended := prefix + nextWord + "."
-
-	if abbreviations[extended] {
-		// The extended form is also an abbreviation; recurse past its dot.
-		return greedyAbbreviation(s, extended, j+1)
-	}
-
-	return true // extension not recognized, current match stands
+// nextPart returns the start of the part after the one at start in a dotted
+// chain ending with the word at wordStart.
+func nextPart(s string, start, wordStart int) int {
+	dot := start + strings.IndexByte(s[start:wordStart], '.')
+	return dot + 1
 }
 
 // wordBefore extracts the word immediately before byte position pos.
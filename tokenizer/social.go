@@ -0,0 +1,353 @@
+package tokenizer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Emoji sequence components.
+const (
+	zwj             = '\u200D' // zero-width joiner
+	variationEmoji  = '\uFE0F' // emoji presentation selector
+	keycapCombining = '\u20E3' // combining enclosing keycap
+)
+
+// bareURLTLDs lists the top-level domains accepted after a bare host name
+// (gov.az, example.com). Hosts starting with www. need no listed TLD.
+var bareURLTLDs = map[string]bool{
+	"az": true, "com": true, "net": true, "org": true, "edu": true, "gov": true,
+	"info": true, "biz": true, "io": true, "co": true, "me": true, "tv": true,
+	"ru": true, "tr": true, "ge": true, "ua": true, "kz": true, "uz": true,
+	"uk": true, "de": true, "fr": true, "us": true, "eu": true,
+	"app": true, "dev": true, "ai": true, "news": true, "online": true, "site": true,
+}
+
+// DNS limits on a bare URL host name.
+const (
+	maxLabelLen = 63
+	maxHostLen  = 253
+)
+
+// emoticons lists emoticons that are not built from eyes, nose and mouth.
+var emoticons = []string{"^_^", "^^", "-_-", "T_T", "<3", "xD", "XD"}
+
+// scanSocial tries the social-mode rules at position pos: emoji, keycaps,
+// emoticons, hashtags, mentions, phone numbers, and bare-domain URLs.
+func scanSocial(s string, pos int) (Token, bool) {
+	r, _ := utf8.DecodeRuneInString(s[pos:])
+	tok := func(end int, typ TokenType) (Token, bool) {
+		return Token{Text: s[pos:end], Start: pos, End: end, Type: typ}, true
+	}
+	if end, ok := scanEmoji(s, pos); ok {
+		return tok(end, Emoji)
+	}
+	if end, ok := scanEmoticon(s, pos); ok {
+		return tok(end, Emoticon)
+	}
+	if !atWordBoundary(s, pos) {
+		return Token{}, false
+	}
+	switch {
+	case r == '#':
+		if end, ok := scanHashtag(s, pos); ok {
+			return tok(end, Hashtag)
+		}
+	case r == '@':
+		if end, ok := scanMention(s, pos); ok {
+			return tok(end, Mention)
+		}
+	case r == '+' || r == '(' || r == '0':
+		if end, ok := scanPhone(s, pos); ok {
+			return tok(end, Phone)
+		}
+	case r < utf8.RuneSelf && isASCIILetterOrDigit(byte(r)):
+		if end, ok := scanBareURL(s, pos); ok {
+			return tok(end, URL)
+		}
+	}
+	return Token{}, false
+}
+
+// atWordBoundary reports whether pos is not preceded by a letter or digit.
+func atWordBoundary(s string, pos int) bool {
+	prev, _ := utf8.DecodeLastRuneInString(s[:pos])
+	return pos == 0 || !unicode.IsLetter(prev) && !unicode.IsDigit(prev)
+}
+
+// isEmojiBase reports whether r is a pictographic emoji rune.
+func isEmojiBase(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF, // pictographs, emoticons, transport, flags
+		r >= 0x2600 && r <= 0x27BF, // miscellaneous symbols, dingbats
+		r >= 0x2B05 && r <= 0x2B55, // arrows, stars, circles
+		r >= 0x231A && r <= 0x231B, r >= 0x23E9 && r <= 0x23FA:
+		return true
+	}
+	return false
+}
+
+// isEmojiModifier reports whether r extends the preceding emoji: a skin
+// tone, the presentation selector, a tag, or the keycap mark.
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF || r == variationEmoji ||
+		r >= 0xE0020 && r <= 0xE007F || r == keycapCombining
+}
+
+// isRegionalIndicator reports whether r is a flag letter.
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// scanEmoji reads one emoji starting at pos: a pictograph with its
+// modifiers and any ZWJ-joined pictographs (👨‍👩‍👧, 👍🏽), a pair of
+// regional indicators (🇦🇿), a keycap (1️⃣), or any symbol with the emoji
+// presentation selector (©️).
+func scanEmoji(s string, pos int) (end int, ok bool) {
+	r, size := utf8.DecodeRuneInString(s[pos:])
+	i := pos + size
+	next := func() rune {
+		nr, _ := utf8.DecodeRuneInString(s[i:])
+		return nr
+	}
+
+	switch {
+	case isRegionalIndicator(r):
+		if nr, ns := utf8.DecodeRuneInString(s[i:]); isRegionalIndicator(nr) {
+			return i + ns, true
+		}
+		return i, true
+	case r == '#' || r == '*' || r >= '0' && r <= '9':
+		j := i
+		if strings.HasPrefix(s[j:], string(variationEmoji)) {
+			j += utf8.RuneLen(variationEmoji)
+		}
+		if strings.HasPrefix(s[j:], string(keycapCombining)) {
+			return j + utf8.RuneLen(keycapCombining), true
+		}
+		return 0, false
+	case !isEmojiBase(r):
+		if r == zwj || unicode.IsLetter(r) || unicode.IsDigit(r) || next() != variationEmoji {
+			return 0, false
+		}
+	}
+
+	for i < len(s) {
+		nr, ns := utf8.DecodeRuneInString(s[i:])
+		if isEmojiModifier(nr) {
+			i += ns
+			continue
+		}
+		if nr == zwj {
+			ar, as := utf8.DecodeRuneInString(s[i+ns:])
+			if isEmojiBase(ar) {
+				i += ns + as
+				continue
+			}
+		}
+		break
+	}
+	return i, true
+}
+
+// scanEmoticon reads an emoticon starting at pos: eyes [:;=], an optional
+// nose [-'^o], and a repeated mouth (":)", ":((", ";-P", ":'("), or one of
+// the emoticons list. The emoticon must not run into a letter or digit.
+// After a letter or digit only smiles and frowns count (super:)), so that
+// "Qeyd:D" and "saat:3" stay apart.
+func scanEmoticon(s string, pos int) (end int, ok bool) {
+	for _, e := range emoticons {
+		if strings.HasPrefix(s[pos:], e) && atWordBoundary(s, pos) && !letterOrDigitAt(s, pos+len(e)) {
+			return pos + len(e), true
+		}
+	}
+
+	if pos >= len(s) || !strings.ContainsRune(":;=", rune(s[pos])) {
+		return 0, false
+	}
+	i := pos + 1
+	if i < len(s) && strings.ContainsRune("-'^o", rune(s[i])) {
+		i++
+	}
+	mouth := i
+	if i >= len(s) || !strings.ContainsRune(")(DPpO|/\\*[]", rune(s[i])) {
+		return 0, false
+	}
+	for i < len(s) && s[i] == s[mouth] {
+		i++
+	}
+	if letterOrDigitAt(s, i) {
+		return 0, false
+	}
+	if !atWordBoundary(s, pos) && s[mouth] != ')' && s[mouth] != '(' {
+		return 0, false
+	}
+	return i, true
+}
+
+// letterOrDigitAt reports whether a letter or digit starts at pos.
+func letterOrDigitAt(s string, pos int) bool {
+	r, _ := utf8.DecodeRuneInString(s[pos:])
+	return pos < len(s) && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+// scanHashtag reads a #tag of letters, digits, and underscores starting at
+// pos. The tag must contain a letter, so #1 is not a hashtag.
+func scanHashtag(s string, pos int) (end int, ok bool) {
+	i := pos + 1
+	letter := false
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			break
+		}
+		letter = letter || unicode.IsLetter(r)
+		i += size
+	}
+	return i, letter
+}
+
+// scanMention reads an @user of ASCII letters, digits, underscores, and
+// inner dots starting at pos.
+func scanMention(s string, pos int) (end int, ok bool) {
+	i := pos + 1
+	for i < len(s) && (isASCIILetterOrDigit(s[i]) || s[i] == '_' || s[i] == '.') {
+		i++
+	}
+	for i > pos+1 && s[i-1] == '.' {
+		i--
+	}
+	if i == pos+1 || letterOrDigitAt(s, i) {
+		return 0, false
+	}
+	return i, true
+}
+
+// phoneSeparators lists the separators accepted between the digit groups
+// of a phone number.
+var phoneSeparators = map[string]bool{
+	" ": true, "-": true, "(": true, ")": true, ") ": true, " (": true, ")-": true,
+}
+
+// Phone number digit counts.
+const (
+	maxPhoneDigits      = 15 // E.164 maximum
+	minIntlPhoneDigits  = 7
+	azPhoneDigits       = 12 // +994 and nine national digits
+	nationalPhoneDigits = 10 // 0XX and seven digits
+)
+
+// scanPhone reads a phone number starting at pos: digit groups separated
+// by a single space, a hyphen, or parentheses. An international number
+// starts with + and has 7 to 15 digits, exactly 12 for +994 (+994 50 123
+// 45 67); a national one has 10 digits starting with a three-digit 0XX
+// code (050-123-45-67, (012) 493-12-34). The longest run of groups that
+// forms a valid number wins, so a following number or count is left out.
+func scanPhone(s string, pos int) (end int, ok bool) {
+	i := pos
+	plus := s[i] == '+'
+	if plus {
+		i++
+	}
+	digits, groups, firstGroup := 0, 0, 0
+	for digits <= maxPhoneDigits {
+		j := i
+		for j < len(s) && j-i < 2 && strings.IndexByte(" -()", s[j]) >= 0 {
+			j++
+		}
+		sep := s[i:j]
+		if groups == 0 && sep != "" && sep != "(" || groups > 0 && !phoneSeparators[sep] {
+			break
+		}
+		k := j
+		for k < len(s) && isDigitByte(s[k]) {
+			k++
+		}
+		if k == j {
+			break
+		}
+		if groups == 0 {
+			firstGroup = k - j
+			if !plus && s[j] != '0' {
+				return 0, false
+			}
+		}
+		digits += k - j
+		groups++
+		i = k
+		if validPhone(s[pos:i], plus, digits, firstGroup) && !letterOrDigitAt(s, i) {
+			end, ok = i, true
+		}
+	}
+	return end, ok
+}
+
+// validPhone reports whether the digit groups in number, which hold the
+// given digit count, form a whole phone number.
+func validPhone(number string, plus bool, digits, firstGroup int) bool {
+	if strings.Count(number, "(") != strings.Count(number, ")") {
+		return false
+	}
+	if !plus {
+		return digits == nationalPhoneDigits && (firstGroup == 3 || firstGroup == nationalPhoneDigits)
+	}
+	if strings.HasPrefix(number, "+994") {
+		return digits == azPhoneDigits
+	}
+	return digits >= minIntlPhoneDigits && digits <= maxPhoneDigits
+}
+
+// scanBareURL reads a URL without a protocol starting at pos: a host of
+// ASCII labels with a lowercase listed TLD or a www. prefix, then an
+// optional port and path. A single trailing . , ! ? is not part of it.
+func scanBareURL(s string, pos int) (end int, ok bool) {
+	i := pos
+	labels, lastLabel := 0, pos
+	for {
+		start := i
+		for i < len(s) && (isASCIILetterOrDigit(s[i]) || s[i] == '-') {
+			i++
+		}
+		// DNS length limits bound the rescan from each word start of a
+		// long dotted or hyphenated run, which keeps WordTokens linear.
+		if i == start || i-start > maxLabelLen || i-pos > maxHostLen {
+			return 0, false
+		}
+		labels++
+		lastLabel = start
+		if i+1 < len(s) && s[i] == '.' && isASCIILetterOrDigit(s[i+1]) {
+			i++
+			continue
+		}
+		break
+	}
+	host := s[pos:i]
+	tld := s[lastLabel:i]
+	www := strings.HasPrefix(strings.ToLower(host), "www.")
+	if labels < 2 || !www && !bareURLTLDs[tld] || !isAllAlpha(tld) {
+		return 0, false
+	}
+	if i < len(s) && (s[i] == '@' || letterOrDigitAt(s, i)) {
+		return 0, false
+	}
+
+	// Port and path run to the next whitespace.
+	if i < len(s) && (s[i] == '/' || s[i] == ':' && i+1 < len(s) && isDigitByte(s[i+1])) {
+		for i < len(s) {
+			r, size := utf8.DecodeRuneInString(s[i:])
+			if unicode.IsSpace(r) {
+				break
+			}
+			i += size
+		}
+		if last := s[i-1]; last == '.' || last == ',' || last == '!' || last == '?' {
+			i--
+		}
+	}
+	return i, true
+}
+
+// isASCIILetterOrDigit reports whether b is an ASCII letter or digit.
+func isASCIILetterOrDigit(b byte) bool {
+	return b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || isDigitByte(b)
+}
@@ -0,0 +1,128 @@
+package tokenizer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// socialTokenizer tokenizes in social mode.
+var socialTokenizer = New(Options{Social: true})
+
+// nonSpace returns the tokens of tokens that are not Space, as Type:Text.
+func nonSpace(tokens []Token) []string {
+	var out []string
+	for _, t := range tokens {
+		if t.Type != Space {
+			out = append(out, t.Type.String()+":"+t.Text)
+		}
+	}
+	return out
+}
+
+func TestSocialTokens(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		// -- Hashtags and mentions --
+		{"#Bakı2024 gözəldir", []string{"Hashtag:#Bakı2024", "Word:gözəldir"}},
+		{"#salam_dünya!", []string{"Hashtag:#salam_dünya", "Punctuation:!"}},
+		{"#1 yer", []string{"Punctuation:#", "Number:1", "Word:yer"}},
+		{"C# dili", []string{"Word:C", "Punctuation:#", "Word:dili"}},
+		{"@aysel.m salam", []string{"Mention:@aysel.m", "Word:salam"}},
+		{"@user_1.", []string{"Mention:@user_1", "Punctuation:."}},
+		{"info@gov.az", []string{"Email:info@gov.az"}},
+
+		// -- Emoji --
+		{"super👍🏽", []string{"Word:super", "Emoji:👍🏽"}},
+		{"👨‍👩‍👧 ailə", []string{"Emoji:👨‍👩‍👧", "Word:ailə"}},
+		{"🇦🇿🇹🇷", []string{"Emoji:🇦🇿", "Emoji:🇹🇷"}},
+		{"❤️❤️", []string{"Emoji:❤️", "Emoji:❤️"}},
+		{"1️⃣ bənd", []string{"Emoji:1️⃣", "Word:bənd"}},
+		{"😂😂", []string{"Emoji:😂", "Emoji:😂"}},
+
+		// -- Emoticons --
+		{"Gəldim :) sən?", []string{"Word:Gəldim", "Emoticon::)", "Word:sən", "Punctuation:?"}},
+		{"yox :((", []string{"Word:yox", "Emoticon::(("}},
+		{"əla;-P", []string{"Word:əla", "Punctuation:;", "Punctuation:-", "Word:P"}},
+		{"əla:)", []string{"Word:əla", "Emoticon::)"}},
+		{":'( <3 ^_^ xD", []string{"Emoticon::'(", "Emoticon:<3", "Emoticon:^_^", "Emoticon:xD"}},
+		{"Qeyd:Dəyər", []string{"Word:Qeyd", "Punctuation::", "Word:Dəyər"}},
+		{"saat 10:30", []string{"Word:saat", "Number:10", "Punctuation::", "Number:30"}},
+
+		// -- Bare URLs --
+		{"Bax www.gov.az saytına.", []string{"Word:Bax", "URL:www.gov.az", "Word:saytına", "Punctuation:."}},
+		{"gov.az/xeberler?id=5, sonra", []string{"URL:gov.az/xeberler?id=5", "Punctuation:,", "Word:sonra"}},
+		{"example.com:8080/api.", []string{"URL:example.com:8080/api", "Punctuation:."}},
+		{"Bakı.Az", []string{"Word:Bakı", "Punctuation:.", "Word:Az"}},
+		{"v2.0 versiya", []string{"Word:v2", "Punctuation:.", "Number:0", "Word:versiya"}},
+
+		// -- Phones --
+		{"Tel: +994 50 123 45 67.", []string{"Word:Tel", "Punctuation::", "Phone:+994 50 123 45 67", "Punctuation:."}},
+		{"+994501234567", []string{"Phone:+994501234567"}},
+		{"050-123-45-67 zəng et", []string{"Phone:050-123-45-67", "Word:zəng", "Word:et"}},
+		{"(012) 493-12-34", []string{"Phone:(012) 493-12-34"}},
+		{"+994 (50) 123-45-67", []string{"Phone:+994 (50) 123-45-67"}},
+		{"+994 50 123 45 67 2 dəfə", []string{"Phone:+994 50 123 45 67", "Number:2", "Word:dəfə"}},
+		{"050-123-45-67 055-123-45-67", []string{"Phone:050-123-45-67", "Phone:055-123-45-67"}},
+		{"(012) 493-12-34 (012) 493-12-35", []string{"Phone:(012) 493-12-34", "Phone:(012) 493-12-35"}},
+		{"+1 202 555 0143 12", []string{"Phone:+1 202 555 0143 12"}},
+		{"01-01-2024 12:30", []string{"Number:01", "Punctuation:-", "Number:01", "Punctuation:-", "Number:2024", "Number:12", "Punctuation::", "Number:30"}},
+		{"+5 dərəcə", []string{"Symbol:+", "Number:5", "Word:dərəcə"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			tokens := socialTokenizer.WordTokens(tt.input)
+			verifyInvariants(t, tt.input, tokens)
+			got := nonSpace(tokens)
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("WordTokens(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestSocialLongRuns verifies that long dotted, hyphenated, and digit runs,
+// which every scanner retries at each word start, tokenize in linear time.
+// A quadratic rescan makes these inputs take minutes.
+func TestSocialLongRuns(t *testing.T) {
+	for _, chunk := range []string{"a.", "a.b.", "a-", "0 ", "0-", "+1 "} {
+		t.Run(chunk, func(t *testing.T) {
+			input := strings.Repeat(chunk, 100000)
+			tokens := socialTokenizer.WordTokens(input)
+			verifyInvariants(t, input, tokens)
+		})
+	}
+}
+
+func TestSocialOffByDefault(t *testing.T) {
+	input := "#Bakı @aysel :) www.gov.az +994501234567 👍🏽"
+	for _, tok := range WordTokens(input) {
+		switch tok.Type {
+		case Hashtag, Mention, Emoji, Emoticon, Phone, URL:
+			t.Errorf("package WordTokens produced social token %v", tok)
+		}
+	}
+}
+
+func TestSocialWords(t *testing.T) {
+	got := socialTokenizer.Words("#Bakı gözəldir 😍 @aysel")
+	if fmt.Sprint(got) != "[gözəldir]" {
+		t.Errorf("Words = %q, want [gözəldir]", got)
+	}
+}
+
+func ExampleOptions_social() {
+	tk := New(Options{Social: true})
+	for _, t := range tk.WordTokens("#Bakı 👍🏽 :) @aysel") {
+		if t.Type != Space {
+			fmt.Printf("%s %q\n", t.Type, t.Text)
+		}
+	}
+	// Output:
+	// Hashtag "#Bakı"
+	// Emoji "👍🏽"
+	// Emoticon ":)"
+	// Mention "@aysel"
+}
@@ -0,0 +1,286 @@
+package tokenizer
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	startBufSize     = 4096    // initial Scanner buffer size
+	defaultMaxBuffer = 1 << 20 // 1 MiB, default longest unbroken span a Scanner accepts
+	maxEmptyReads    = 100     // consecutive empty reads before giving up
+)
+
+// ErrTooLong is returned by Scanner.Err when a span that cannot be split
+// is longer than the buffer maximum: a run of text without whitespace for
+// a word Scanner, a single sentence for a sentence Scanner.
+var ErrTooLong = errors.New("tokenizer.Scanner: token too long")
+
+// Scanner reads tokens from an io.Reader, like bufio.Scanner, without
+// holding the whole input in memory. Token offsets are global byte offsets
+// into the stream, and the tokens are exactly those that WordTokens or
+// SentenceTokens return for the whole input read as one string, including
+// tokens and sentences that straddle buffer boundaries.
+//
+// A word Scanner only emits the tokens before whitespace that no token can
+// span: whitespace other than a single space, or a single space followed by
+// more single spaces than a Phone, Money, or Measurement token holds. A
+// sentence Scanner holds back the sentences that end in the buffer's
+// trailing whitespace or run into its last line, until more input or the
+// end of the input settles them, and continues the Markdown block it cut
+// its buffer in. The one exception to matching the whole-input result is a
+// protected pattern whose match contains whitespace: a Scanner may cut its
+// buffer inside such a match, which then splits. A Scanner is not safe for
+// concurrent use.
+type Scanner struct {
+	r        io.Reader
+	split    func(string) []Token
+	cut      func(string, []Token) int // number of tokens that more input cannot change
+	block    *cutBlock                 // block a sentence Scanner last cut its buffer in
+	buf      []byte
+	n        int     // bytes of buf in use
+	base     int     // stream offset of buf[0]
+	max      int     // maximum buffer size
+	tokens   []Token // tokens ready to emit
+	next     int     // index of the next token in tokens
+	tok      Token
+	err      error
+	done     bool // input exhausted or failed
+	scanning bool
+}
+
+// NewScanner returns a Scanner that reads word-level tokens from r.
+// It yields the same Word, Number, Punctuation, Space, Symbol, URL, and
+// Email tokens as WordTokens.
+func NewScanner(r io.Reader) *Scanner {
+	return defaultTokenizer.NewScanner(r)
+}
+
+// NewScanner is like the package-level NewScanner but yields the tokens of
+// the Tokenizer's WordTokens.
+func (tk *Tokenizer) NewScanner(r io.Reader) *Scanner {
+	return &Scanner{r: r, split: tk.WordTokens, cut: tk.wordCut, max: defaultMaxBuffer}
+}
+
+// NewSentenceScanner returns a Scanner that reads sentence tokens from r.
+// It yields the same Sentence tokens as SentenceTokens.
+func NewSentenceScanner(r io.Reader) *Scanner {
+	return defaultTokenizer.NewSentenceScanner(r)
+}
+
+// NewSentenceScanner is like the package-level NewSentenceScanner but
+// yields the sentences of the Tokenizer's SentenceTokens.
+func (tk *Tokenizer) NewSentenceScanner(r io.Reader) *Scanner {
+	sc := &Scanner{r: r, max: defaultMaxBuffer}
+	sc.split = func(s string) []Token { return tk.sentenceTokens(s, sc.block) }
+	sc.cut = sc.sentenceCut
+	return sc
+}
+
+// Buffer sets the initial buffer and the maximum size the buffer may grow
+// to, as bufio.Scanner.Buffer does. The default is 4 KiB growing to 1 MiB.
+// Buffer panics if it is called after scanning has started.
+func (sc *Scanner) Buffer(buf []byte, max int) {
+	if sc.scanning {
+		panic("tokenizer: Buffer called after Scan")
+	}
+	sc.buf = buf[0:cap(buf)]
+	sc.max = max
+}
+
+// Scan advances to the next token, which is then available through Token.
+// It returns false when the input is exhausted or an error occurred; Err
+// then reports the error, if any.
+func (sc *Scanner) Scan() bool {
+	sc.scanning = true
+	for {
+		if sc.next < len(sc.tokens) {
+			sc.tok = sc.tokens[sc.next]
+			sc.next++
+			return true
+		}
+		if sc.done {
+			sc.tok = Token{}
+			return false
+		}
+		sc.fill()
+	}
+}
+
+// Token returns the most recent token read by Scan.
+func (sc *Scanner) Token() Token {
+	return sc.tok
+}
+
+// Err returns the first non-EOF error encountered by the Scanner.
+func (sc *Scanner) Err() error {
+	return sc.err
+}
+
+// fill reads more input and queues every token that more input can no
+// longer change. At the end of the input all remaining tokens are queued.
+func (sc *Scanner) fill() {
+	if sc.n == len(sc.buf) {
+		if !sc.grow() {
+			return
+		}
+	}
+
+	eof := false
+	for empty := 0; ; empty++ {
+		m, err := sc.r.Read(sc.buf[sc.n:])
+		sc.n += m
+		if err != nil {
+			if err != io.EOF {
+				sc.err = err
+			}
+			eof = true
+			break
+		}
+		if m > 0 {
+			break
+		}
+		if empty == maxEmptyReads {
+			sc.err = io.ErrNoProgress
+			eof = true
+			break
+		}
+	}
+
+	if sc.n == 0 {
+		sc.done = eof
+		return
+	}
+	s := string(sc.buf[:sc.n])
+	tokens := sc.split(s)
+	keep := len(tokens)
+	if !eof {
+		keep = sc.cut(s, tokens)
+	}
+	consumed := 0
+	if keep > 0 {
+		consumed = tokens[keep-1].End
+	}
+	sc.tokens = tokens[:keep]
+	sc.next = 0
+	for i := range sc.tokens {
+		sc.tokens[i].Start += sc.base
+		sc.tokens[i].End += sc.base
+	}
+
+	sc.n = copy(sc.buf, sc.buf[consumed:sc.n])
+	sc.base += consumed
+	sc.done = eof
+}
+
+// grow doubles the buffer up to the maximum. It reports false and sets
+// ErrTooLong if the buffer is already at the maximum.
+func (sc *Scanner) grow() bool {
+	if len(sc.buf) >= sc.max {
+		sc.err = ErrTooLong
+		sc.done = true
+		return false
+	}
+	size := startBufSize
+	if len(sc.buf) > 0 {
+		size = 2 * len(sc.buf)
+	}
+	size = min(size, sc.max)
+	buf := make([]byte, size)
+	copy(buf, sc.buf[:sc.n])
+	sc.buf = buf
+	return true
+}
+
+// wordCut returns the index of the last Space token that no token can
+// span. Every token before it is final: URLs, emails, numbers, and words
+// all end at whitespace, and a Phone, Money, or Measurement token only
+// spans single spaces, at most maxTokenSpaces of them, so more input can
+// only extend the whitespace run or what follows it.
+func (tk *Tokenizer) wordCut(_ string, tokens []Token) int {
+	spaces := 0
+	for k := len(tokens) - 1; k > 0; k-- {
+		if tokens[k].Type != Space {
+			continue
+		}
+		spaces++
+		if tokens[k].Text != " " || spaces > tk.maxTokenSpaces() {
+			return k
+		}
+	}
+	return 0
+}
+
+// maxTokenSpaces returns the most single spaces one token of the
+// Tokenizer can hold: those between the digit groups of a Phone, or the
+// one after the amount of a Money or Measurement token (100 AZN).
+func (tk *Tokenizer) maxTokenSpaces() int {
+	switch {
+	case tk.social:
+		return maxPhoneDigits - 1
+	case tk.quantities:
+		return 1
+	}
+	return 0
+}
+
+// sentenceCut returns the number of sentences that end before the last
+// settled rune of s, and records the block the rest of s continues. A break
+// decision looks ahead over terminal punctuation, closing quotes,
+// whitespace, and opening quotes to the next rune, so decisions before a
+// rune of any other kind are final, while a sentence ending in such
+// trailing runes may still move. Whether a line starts a block is settled
+// only by the next line (a table header row by its delimiter row), so a
+// sentence that runs into the last line, or into a line with a | before
+// it, is held back as well.
+func (sc *Scanner) sentenceCut(s string, tokens []Token) int {
+	last := lastSettledRune(s)
+	line := strings.LastIndexByte(s, '\n') + 1 // start of the last line
+	prev := 0                                  // start of the line before it, if it has a |
+	if line > 0 {
+		prev = strings.LastIndexByte(s[:line-1], '\n') + 1
+		if !strings.Contains(s[prev:line], "|") {
+			prev = 0
+		}
+	}
+	k := 0
+	for k < len(tokens) && tokens[k].End <= last &&
+		!runsInto(s, tokens[k], line) && !runsInto(s, tokens[k], prev) {
+		k++
+	}
+	if k > 0 {
+		sc.block = blockAt(s, tokens[k-1].End, sc.block)
+	}
+	return k
+}
+
+// runsInto reports whether the sentence t has text before the line start
+// pos and reaches it, so that whether a block starts at pos decides where
+// t ends.
+func runsInto(s string, t Token, pos int) bool {
+	return t.Start < pos && pos <= t.End && !isBlank(s[t.Start:pos])
+}
+
+// lastSettledRune returns the offset of the last complete rune of s that
+// ends a break lookahead, ignoring an incomplete UTF-8 sequence at the end,
+// or 0 if there is none.
+func lastSettledRune(s string) int {
+	i := len(s)
+	for j := max(len(s)-utf8.UTFMax+1, 0); j < len(s); j++ {
+		if utf8.RuneStart(s[j]) && !utf8.FullRuneInString(s[j:]) {
+			i = j
+			break
+		}
+	}
+	for i > 0 {
+		r, size := utf8.DecodeLastRuneInString(s[:i])
+		i -= size
+		if !unicode.IsSpace(r) && !isQuoteOrBracket(r) && !isTerminal(r) {
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,209 @@
+package tokenizer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// streamInputs straddle small buffers with every kind of multi-rune token.
+var streamInputs = []string{
+	"",
+	"Salam",
+	"Salam, d\u00fcnya!",
+	"   \n\t  ",
+	"Bak\u0131\u2019n\u0131n k\u00fc\u00e7\u0259l\u0259ri sosial-iqtisadi inki\u015faf.",
+	"Qiym\u0259t 1.000.000,50 manat v\u0259 3,14 faiz.",
+	"\u018etrafl\u0131: https://gov.az/news?id=1. Yaz\u0131n: info@gov.az",
+	"Prof. \u018eliyev g\u0259ldi. Az.R. qanunu q\u0259bul edildi!\n\nYeni abzas... Son?! Bitdi",
+	"emoji \U0001F600 v\u0259 \u4e2d\u6587 \xff\xfe bitdi",
+	"a--b \u2014 c - d",
+	"Son.\n\n\u3000Yeni c\u00fcml\u0259.\n\nBitdi",
+	strings.Repeat("Salam d\u00fcnya! Az\u0259rbaycan. ", 200),
+	"Qeyd (bax. Bir. \u0130ki. \u00dc\u00e7. D\u00f6rd. Be\u015f. Son.",
+	"O dedi: \u00abG\u0259l. Otur.\u00bb Sonra \"getdi. Bir. \u0130ki. \u00dc\u00e7. D\u00f6rd.",
+	"# Giri\u015f\nBu m\u0259tndir. Sonra.\n- birinci b\u0259nd. Bir\n- ikinci b\u0259nd\n2. Il\u0259\n",
+	"M\u0259tn. Bir | iki. \u00dc\u00e7\n|---|---|\n| Ad. Soyad | 2 |\nSonra. Bitdi\n```\nKod. Bir\n```\nSon.",
+	"Bir. - iki. \u00dc\u00e7\n2. il\u0259. Son\nBa\u015fl\u0131q. Bir\n======\nM\u0259tn.",
+}
+
+// scanAll drains sc and returns the tokens it yielded.
+func scanAll(t *testing.T, sc *Scanner) []Token {
+	t.Helper()
+	var got []Token
+	for sc.Scan() {
+		got = append(got, sc.Token())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	return got
+}
+
+func TestScannerMatchesWordTokens(t *testing.T) {
+	for _, input := range streamInputs {
+		want := WordTokens(input)
+		for _, size := range []int{1, 2, 3, 7, 16, 4096} {
+			sc := NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+			sc.Buffer(make([]byte, size), 1<<20)
+			if got := scanAll(t, sc); !slices.Equal(got, want) {
+				t.Errorf("buffer %d, input %q:\ngot  %v\nwant %v", size, input, got, want)
+			}
+		}
+		if got := scanAll(t, NewScanner(strings.NewReader(input))); !slices.Equal(got, want) {
+			t.Errorf("default buffer, input %q:\ngot  %v\nwant %v", input, got, want)
+		}
+	}
+}
+
+func TestScannerMatchesSentenceTokens(t *testing.T) {
+	for _, input := range streamInputs {
+		want := SentenceTokens(input)
+		for _, size := range []int{1, 2, 3, 7, 16, 4096} {
+			sc := NewSentenceScanner(iotest.HalfReader(strings.NewReader(input)))
+			sc.Buffer(make([]byte, size), 1<<20)
+			if got := scanAll(t, sc); !slices.Equal(got, want) {
+				t.Errorf("buffer %d, input %q:\ngot  %v\nwant %v", size, input, got, want)
+			}
+		}
+	}
+}
+
+// TestTokenizerScanner checks the Scanners of a configured Tokenizer
+// against its WordTokens and SentenceTokens, including the tokens that
+// span single spaces.
+func TestTokenizerScanner(t *testing.T) {
+	inputs := append([]string{
+		"Z\u0259ng: +994 50 123 45 67 v\u0259 050 123 45 67, (012) 493-12-34.",
+		"Qiym\u0259t 100 AZN, $ 25 v\u0259 2 manat. S\u00fcr\u0259t 10 km/saat idi.",
+		"N\u00f6mr\u0259: +1 2 3 4 5 6 7 8 9 0 1 2 3 4 5 son",
+		"M\u0259s. bu g\u00fcn 3-c\u00fc d\u0259f\u0259 15%-i #xeb\u0259r @user :) g\u0259ldi.",
+	}, streamInputs...)
+	for _, opts := range []Options{
+		{Quantities: true},
+		{Social: true, Quantities: true, Abbreviations: []string{"m\u0259s."}},
+	} {
+		tk := New(opts)
+		for _, input := range inputs {
+			words, sentences := tk.WordTokens(input), tk.SentenceTokens(input)
+			for _, size := range []int{1, 2, 3, 7, 16, 4096} {
+				sc := tk.NewScanner(iotest.OneByteReader(strings.NewReader(input)))
+				sc.Buffer(make([]byte, size), 1<<20)
+				if got := scanAll(t, sc); !slices.Equal(got, words) {
+					t.Errorf("%+v, buffer %d, input %q:\ngot  %v\nwant %v", opts, size, input, got, words)
+				}
+				sc = tk.NewSentenceScanner(iotest.HalfReader(strings.NewReader(input)))
+				sc.Buffer(make([]byte, size), 1<<20)
+				if got := scanAll(t, sc); !slices.Equal(got, sentences) {
+					t.Errorf("%+v, buffer %d, input %q:\ngot  %v\nwant %v", opts, size, input, got, sentences)
+				}
+			}
+		}
+	}
+}
+
+// TestScannerLargeInput streams more than the maximum buffer size and
+// checks the global offsets against the whole input.
+func TestScannerLargeInput(t *testing.T) {
+	input := strings.Repeat("Salam d\u00fcnya! Az\u0259rbaycan. ", 100000) // > 2MB
+	sc := NewScanner(strings.NewReader(input))
+	var n int
+	for sc.Scan() {
+		tok := sc.Token()
+		if input[tok.Start:tok.End] != tok.Text {
+			t.Fatalf("token %d offset invariant broken: %v", n, tok)
+		}
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if want := len(WordTokens(input)); n != want {
+		t.Errorf("got %d tokens, want %d", n, want)
+	}
+}
+
+// TestSentenceScannerStrayOpener streams more than the maximum buffer size
+// after a stray opening bracket, which must not hold back every sentence.
+func TestSentenceScannerStrayOpener(t *testing.T) {
+	input := "(" + strings.Repeat("Bu g\u00fcn hava yax\u015f\u0131d\u0131r. ", 90000) // > 2MB
+	sc := NewSentenceScanner(strings.NewReader(input))
+	n := 0
+	for sc.Scan() {
+		n++
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if n != 90000 {
+		t.Errorf("got %d sentences, want 90000", n)
+	}
+}
+
+func TestScannerTooLong(t *testing.T) {
+	sc := NewScanner(strings.NewReader("Salam " + strings.Repeat("a", 100) + " son"))
+	sc.Buffer(make([]byte, 8), 32)
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Token().Text)
+	}
+	if !errors.Is(sc.Err(), ErrTooLong) {
+		t.Errorf("Err() = %v, want ErrTooLong", sc.Err())
+	}
+	if !slices.Equal(got, []string{"Salam"}) {
+		t.Errorf("tokens before error = %q, want [Salam]", got)
+	}
+}
+
+func TestScannerReadError(t *testing.T) {
+	errRead := errors.New("read failed")
+	r := io.MultiReader(strings.NewReader("Salam d\u00fcnya"), iotest.ErrReader(errRead))
+	sc := NewScanner(r)
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Token().Text)
+	}
+	if sc.Err() != errRead {
+		t.Errorf("Err() = %v, want %v", sc.Err(), errRead)
+	}
+	if !slices.Equal(got, []string{"Salam", " ", "d\u00fcnya"}) {
+		t.Errorf("tokens before error = %q", got)
+	}
+}
+
+func TestScannerBufferAfterScan(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Buffer after Scan did not panic")
+		}
+	}()
+	sc := NewScanner(strings.NewReader("Salam"))
+	sc.Scan()
+	sc.Buffer(nil, 64)
+}
+
+func BenchmarkScanner(b *testing.B) {
+	input := strings.Repeat("Prof. \u018eliyev 1.000 manat \u00f6d\u0259di. Bak\u0131\u2019n\u0131n k\u00fc\u00e7\u0259l\u0259ri g\u00f6z\u0259ldir! ", 1000)
+	b.SetBytes(int64(len(input)))
+	b.ResetTimer()
+	for b.Loop() {
+		sc := NewScanner(strings.NewReader(input))
+		for sc.Scan() {
+		}
+	}
+}
+
+func ExampleScanner() {
+	sc := NewSentenceScanner(strings.NewReader("Birinci c\u00fcml\u0259. \u0130kinci c\u00fcml\u0259."))
+	for sc.Scan() {
+		t := sc.Token()
+		fmt.Printf("%d:%d %q\n", t.Start, t.End, t.Text)
+	}
+	// Output:
+	// 0:16 "Birinci cümlə."
+	// 16:33 " İkinci cümlə."
+}
@@ -1,28 +1,47 @@
 // Package tokenizer splits Azerbaijani text into words, sentences, and
 // structured tokens with byte offsets.
 //
-// The package provides two API layers:
+// The package provides three API layers:
 //
-//   - Structured: WordTokens and SentenceTokens return []Token with byte
-//     offsets and type metadata. The invariant s[t.Start:t.End] == t.Text
-//     holds for every token, and concatenating all token texts reconstructs
-//     the original string.
+//   - Structured: WordTokens, SentenceTokens, and DocumentTokens return
+//     []Token with byte offsets and type metadata. The invariant
+//     s[t.Start:t.End] == t.Text holds for every token, and concatenating
+//     all token texts reconstructs the original string.
 //
-//   - Convenience: Words and Sentences return []string for common use cases
-//     where offsets and types are not needed.
+//   - Convenience: Words, Sentences, and Blocks return []string for common
+//     use cases where offsets and types are not needed.
 //
-// All functions are safe for concurrent use by multiple goroutines.
+//   - Streaming: NewScanner and NewSentenceScanner read the same tokens from
+//     an io.Reader with global byte offsets, for inputs too large to hold in
+//     memory as one string.
+//
+// A Tokenizer created with New offers the same functions with extra
+// abbreviations, non-breaking prefixes, and protected patterns.
+//
+// Detokenize and DetokenizeTokens rebuild text from edited words or tokens
+// with Azerbaijani spacing conventions.
+//
+// An OffsetMap converts byte offsets, from this or any other package of the
+// module, into rune offsets, UTF-16 offsets, and line and column positions.
+//
+// All functions are safe for concurrent use by multiple goroutines; a
+// Scanner is not.
 //
 // Known limitations (v1.0):
 //
-//   - Sentence splitting does not track quote or parenthesis nesting.
-//     Terminal punctuation inside quotes may cause false sentence breaks.
-//   - Bare URLs without a protocol prefix (www.example.com) are not detected.
-//     Only http:// and https:// prefixed URLs are recognized.
+//   - Quote and bracket nesting is tracked only within a block. A quote
+//     left unclosed suppresses sentence breaks until the next block or
+//     until it has held back four of them, after which it is taken for a
+//     stray opener and the breaks are made; a quotation of five or more
+//     sentences is split the same way.
+//   - DocumentTokens recognizes the common Markdown blocks only: indented
+//     code, block quotes, and HTML blocks are read as paragraphs, and list
+//     items are not nested.
+//   - Bare URLs without a protocol prefix (www.example.com) are detected only
+//     by a Tokenizer with Options.Social set.
 //   - Single-letter abbreviations (m., s., d.) are not in the built-in list
-//     due to ambiguity with sentence-ending periods.
-//   - Az.R. and similar multi-part abbreviations followed by an uppercase letter
-//     may cause a false sentence break, since the splitter sees period + uppercase.
+//     due to ambiguity with sentence-ending periods. Add them with
+//     Options.Abbreviations where a document's conventions allow.
 package tokenizer
 
 import (
@@ -43,9 +62,23 @@ const (
 	Punctuation                  // Punctuation marks: . , ! ? : ; ( ) etc.
 	Space                        // Contiguous whitespace (spaces, tabs, newlines)
 	Symbol                       // Everything else: emoji, CJK, mathematical symbols, etc.
-	URL                          // http:// or https:// prefixed sequences
+	URL                          // http:// or https:// prefixed sequences; bare domains in social mode
 	Email                        // user@domain.tld sequences
 	Sentence                     // Used only by SentenceTokens — a full sentence
+	Hashtag                      // #tag (social mode)
+	Mention                      // @user (social mode)
+	Emoji                        // Emoji, including ZWJ sequences, modifiers, flags, keycaps (social mode)
+	Emoticon                     // :) :(( ;-) <3 ^_^ (social mode)
+	Phone                        // +994 50 123 45 67, (012) 493-12-34 (social mode)
+	Money                        // 100 AZN, ₼25, 2 manat (quantities mode)
+	Percent                      // 15%, %15 (quantities mode)
+	Ordinal                      // 3-cü, 2024-cü (quantities mode)
+	Measurement                  // 10 km/saat, 25°C (quantities mode)
+	Paragraph                    // Used only by DocumentTokens — a paragraph of text
+	Heading                      // Used only by DocumentTokens — # Başlıq or an underlined title
+	ListItem                     // Used only by DocumentTokens — - bənd, 1. bənd, a) bənd
+	CodeBlock                    // Used only by DocumentTokens — a fenced ``` or ~~~ block
+	Table                        // Used only by DocumentTokens — a | pipe | table |
 )
 
 // tokenTypeNames maps TokenType values to their string names.
@@ -58,6 +91,20 @@ var tokenTypeNames = [...]string{
 	URL:         "URL",
 	Email:       "Email",
 	Sentence:    "Sentence",
+	Hashtag:     "Hashtag",
+	Mention:     "Mention",
+	Emoji:       "Emoji",
+	Emoticon:    "Emoticon",
+	Phone:       "Phone",
+	Money:       "Money",
+	Percent:     "Percent",
+	Ordinal:     "Ordinal",
+	Measurement: "Measurement",
+	Paragraph:   "Paragraph",
+	Heading:     "Heading",
+	ListItem:    "ListItem",
+	CodeBlock:   "CodeBlock",
+	Table:       "Table",
 }
 
 // tokenTypeFromName maps string names back to TokenType values.
@@ -70,6 +117,20 @@ var tokenTypeFromName = map[string]TokenType{
 	"URL":         URL,
 	"Email":       Email,
 	"Sentence":    Sentence,
+	"Hashtag":     Hashtag,
+	"Mention":     Mention,
+	"Emoji":       Emoji,
+	"Emoticon":    Emoticon,
+	"Phone":       Phone,
+	"Money":       Money,
+	"Percent":     Percent,
+	"Ordinal":     Ordinal,
+	"Measurement": Measurement,
+	"Paragraph":   Paragraph,
+	"Heading":     Heading,
+	"ListItem":    ListItem,
+	"CodeBlock":   CodeBlock,
+	"Table":       Table,
 }
 
 // String returns the name of the token type.
@@ -101,10 +162,13 @@ func (t *TokenType) UnmarshalJSON(data []byte) error {
 
 // Token represents a unit of text with its position and classification.
 type Token struct {
-	Text  string    `json:"text"`  // The token text
-	Start int       `json:"start"` // Byte offset in the original string (inclusive)
-	End   int       `json:"end"`   // Byte offset in the original string (exclusive)
-	Type  TokenType `json:"type"`  // Classification of the token
+	Text  string    `json:"text"`            // The token text
+	Start int       `json:"start"`           // Byte offset in the original string (inclusive)
+	End   int       `json:"end"`             // Byte offset in the original string (exclusive)
+	Type  TokenType `json:"type"`            // Classification of the token
+	Value float64   `json:"value,omitempty"` // Amount of a Money, Percent, Ordinal, or Measurement token
+	Unit  string    `json:"unit,omitempty"`  // Currency code, "%", or unit of measure of Value
+	Level int       `json:"level,omitempty"` // Level of a Heading, 1 to 6
 }
 
 // String returns a debug representation, e.g. Word("salam")[0:5].
@@ -117,20 +181,32 @@ func (t Token) String() string {
 // The byte offset invariant s[t.Start:t.End] == t.Text holds for every token.
 // Concatenating all token texts reconstructs the original string.
 func WordTokens(s string) []Token {
+	return defaultTokenizer.WordTokens(s)
+}
+
+// WordTokens is like the package-level WordTokens but keeps each match of
+// the Tokenizer's protected patterns in one token.
+func (tk *Tokenizer) WordTokens(s string) []Token {
 	if s == "" {
 		return nil
 	}
-	return wordTokens(s)
+	return protectTokens(s, tk.wordTokens(s), tk.protectedSpans(s))
 }
 
 // Words returns only Word-type token texts from the text.
 // Does not include Number, Punctuation, URL, Email, or other types.
 // For full control, use WordTokens and filter by Type.
 func Words(s string) []string {
-	if s == "" {
+	return defaultTokenizer.Words(s)
+}
+
+// Words is like the package-level Words but uses the Tokenizer's
+// protected patterns.
+func (tk *Tokenizer) Words(s string) []string {
+	tokens := tk.WordTokens(s)
+	if tokens == nil {
 		return nil
 	}
-	tokens := wordTokens(s)
 	words := make([]string, 0, len(tokens)/wordsPerTokenEstimate)
 	for _, t := range tokens {
 		if t.Type == Word {
@@ -143,24 +219,69 @@ func Words(s string) []string {
 // SentenceTokens splits text into sentence-level tokens with byte offsets.
 // Each returned Token has Type=Sentence.
 // Sentence boundaries are determined by terminal punctuation (. ? !) followed
-// by whitespace and an uppercase letter, or by double newlines.
+// by whitespace and an uppercase letter, by blank lines ("\n\n", "\r\n\r\n"),
+// and by the start of each block DocumentTokens finds (# Başlıq, - bənd, a
+// table, or fenced code).
 // A built-in abbreviation list prevents false breaks after common abbreviations.
 func SentenceTokens(s string) []Token {
+	return defaultTokenizer.SentenceTokens(s)
+}
+
+// SentenceTokens is like the package-level SentenceTokens but also keeps
+// sentences unbroken after the Tokenizer's abbreviations and non-breaking
+// prefixes and inside matches of its protected patterns.
+func (tk *Tokenizer) SentenceTokens(s string) []Token {
 	if s == "" {
 		return nil
 	}
-	return sentenceTokens(s)
+	return tk.sentenceTokens(s, nil)
 }
 
 // Sentences returns sentence strings from the text.
 func Sentences(s string) []string {
-	if s == "" {
+	return defaultTokenizer.Sentences(s)
+}
+
+// Sentences is like the package-level Sentences but splits with the
+// Tokenizer's rules.
+func (tk *Tokenizer) Sentences(s string) []string {
+	tokens := tk.SentenceTokens(s)
+	if tokens == nil {
 		return nil
 	}
-	tokens := sentenceTokens(s)
 	sentences := make([]string, len(tokens))
 	for i, t := range tokens {
 		sentences[i] = t.Text
 	}
 	return sentences
 }
+
+// DocumentTokens splits text into block-level tokens with byte offsets:
+// Paragraph, Heading, ListItem, CodeBlock, and Table. It reads plain text
+// and Markdown, with "\n" or "\r\n" line endings. Blank lines separate
+// paragraphs; headings (# Başlıq, or a title underlined with === or ---),
+// list items (-, *, +, •, 1., 1), a)), fenced code blocks, and pipe tables
+// are blocks of their own. A Heading's level (1 to 6) is in Token.Level.
+//
+// Each block includes the blank lines that follow it, so concatenating all
+// token texts reconstructs s. SentenceTokens and WordTokens can be applied
+// to each block's Text, adding Token.Start to their offsets.
+func DocumentTokens(s string) []Token {
+	if s == "" {
+		return nil
+	}
+	return documentTokens(s)
+}
+
+// Blocks returns the block strings of the text, as split by DocumentTokens.
+func Blocks(s string) []string {
+	tokens := DocumentTokens(s)
+	if tokens == nil {
+		return nil
+	}
+	blocks := make([]string, len(tokens))
+	for i, t := range tokens {
+		blocks[i] = t.Text
+	}
+	return blocks
+}
@@ -402,10 +402,26 @@ func TestSentenceTokens(t *testing.T) {
 		{"multi-part abbreviation", "Az.R. qanunu.", []Token{
 			{Text: "Az.R. qanunu.", Start: 0, End: 13, Type: Sentence},
 		}},
+		{"multi-part abbreviation before uppercase", "Az.R. Konstitusiyas\u0131.", []Token{
+			{Text: "Az.R. Konstitusiyas\u0131.", Start: 0, End: 22, Type: Sentence},
+		}},
+		{"multi-part abbreviation e.\u0259.", "IV \u0259sr e.\u0259. Roma.", []Token{
+			{Text: "IV \u0259sr e.\u0259. Roma.", Start: 0, End: 19, Type: Sentence},
+		}},
 		{"multi-word abbreviation", "Kitablar v\u0259 s. sat\u0131ld\u0131.", []Token{
 			{Text: "Kitablar v\u0259 s. sat\u0131ld\u0131.", Start: 0, End: 26, Type: Sentence},
 		}},
 
+		// -- Quotes and brackets --
+
+		{"quoted sentences stay together", "O dedi: \u00abG\u0259lir\u0259m. G\u00f6zl\u0259.\u00bb Sonra getdi.", []Token{
+			{Text: "O dedi: \u00abG\u0259lir\u0259m. G\u00f6zl\u0259.\u00bb", Start: 0, End: 31, Type: Sentence},
+			{Text: " Sonra getdi.", Start: 31, End: 44, Type: Sentence},
+		}},
+		{"parenthesis stays together", "Bu (Bax. C\u0259dv\u0259l.) do\u011frudur.", []Token{
+			{Text: "Bu (Bax. C\u0259dv\u0259l.) do\u011frudur.", Start: 0, End: 30, Type: Sentence},
+		}},
+
 		// -- Ellipsis --
 
 		{"ellipsis splits", "Ola bil\u0259r... B\u0259lk\u0259.", []Token{
@@ -513,6 +529,15 @@ func TestTokenTypeString(t *testing.T) {
 		{URL, "URL"},
 		{Email, "Email"},
 		{Sentence, "Sentence"},
+		{Hashtag, "Hashtag"},
+		{Mention, "Mention"},
+		{Emoji, "Emoji"},
+		{Emoticon, "Emoticon"},
+		{Phone, "Phone"},
+		{Money, "Money"},
+		{Percent, "Percent"},
+		{Ordinal, "Ordinal"},
+		{Measurement, "Measurement"},
 		{TokenType(99), "TokenType(99)"},
 	}
 	for _, tt := range tests {
@@ -629,6 +654,93 @@ func ExampleSentences() {
 	// [Birinci.  İkinci.]
 }
 
+// ---------------------------------------------------------------------------
+// Quantities mode
+// ---------------------------------------------------------------------------
+
+func TestQuantityTokens(t *testing.T) {
+	tk := New(Options{Quantities: true})
+	tests := []struct {
+		input string
+		want  Token // the first token
+	}{
+		// -- Money --
+		{"100 AZN", Token{Text: "100 AZN", Start: 0, End: 7, Type: Money, Value: 100, Unit: "AZN"}},
+		{"\u20bc25", Token{Text: "\u20bc25", Start: 0, End: 5, Type: Money, Value: 25, Unit: "AZN"}},
+		{"25\u20bc-d\u0259n", Token{Text: "25\u20bc-d\u0259n", Start: 0, End: 10, Type: Money, Value: 25, Unit: "AZN"}},
+		{"100 AZN-d\u0259n", Token{Text: "100 AZN-d\u0259n", Start: 0, End: 12, Type: Money, Value: 100, Unit: "AZN"}},
+		{"$ 1.000,50 \u00f6d\u0259di", Token{Text: "$ 1.000,50", Start: 0, End: 10, Type: Money, Value: 1000.5, Unit: "USD"}},
+		{"5 manatdan az", Token{Text: "5 manatdan", Start: 0, End: 10, Type: Money, Value: 5, Unit: "AZN"}},
+		{"50 q\u0259pik", Token{Text: "50 q\u0259pik", Start: 0, End: 9, Type: Money, Value: 0.5, Unit: "AZN"}},
+		{"20 dollarl\u0131q", Token{Text: "20 dollarl\u0131q", Start: 0, End: 13, Type: Money, Value: 20, Unit: "USD"}},
+
+		// -- Percent --
+		{"15%", Token{Text: "15%", Start: 0, End: 3, Type: Percent, Value: 15, Unit: "%"}},
+		{"%15 art\u0131m", Token{Text: "%15", Start: 0, End: 3, Type: Percent, Value: 15, Unit: "%"}},
+		{"2,5 %", Token{Text: "2,5 %", Start: 0, End: 5, Type: Percent, Value: 2.5, Unit: "%"}},
+		{"12.5%", Token{Text: "12.5%", Start: 0, End: 5, Type: Percent, Value: 12.5, Unit: "%"}},
+		{"%12.5", Token{Text: "%12.5", Start: 0, End: 5, Type: Percent, Value: 12.5, Unit: "%"}},
+		{"15%-i", Token{Text: "15%-i", Start: 0, End: 5, Type: Percent, Value: 15, Unit: "%"}},
+		{"\u22123%", Token{Text: "\u22123%", Start: 0, End: 5, Type: Percent, Value: -3, Unit: "%"}},
+
+		// -- Ordinal --
+		{"3-c\u00fc", Token{Text: "3-c\u00fc", Start: 0, End: 5, Type: Ordinal, Value: 3}},
+		{"2024-c\u00fc il", Token{Text: "2024-c\u00fc", Start: 0, End: 8, Type: Ordinal, Value: 2024}},
+		{"1-cid\u0259n", Token{Text: "1-cid\u0259n", Start: 0, End: 8, Type: Ordinal, Value: 1}},
+		{"5-inci", Token{Text: "5-inci", Start: 0, End: 6, Type: Ordinal, Value: 5}},
+		{"3-c\u00fcs\u00fc", Token{Text: "3-c\u00fcs\u00fc", Start: 0, End: 8, Type: Ordinal, Value: 3}},
+
+		// -- Measurement --
+		{"10 km/saat", Token{Text: "10 km/saat", Start: 0, End: 10, Type: Measurement, Value: 10, Unit: "km/saat"}},
+		{"25\u00b0C", Token{Text: "25\u00b0C", Start: 0, End: 5, Type: Measurement, Value: 25, Unit: "\u00b0C"}},
+		{"120 m\u00b2 m\u0259nzil", Token{Text: "120 m\u00b2", Start: 0, End: 7, Type: Measurement, Value: 120, Unit: "m\u00b2"}},
+		{"3,5 kq", Token{Text: "3,5 kq", Start: 0, End: 6, Type: Measurement, Value: 3.5, Unit: "kq"}},
+		{"-5\u00b0C", Token{Text: "-5\u00b0C", Start: 0, End: 5, Type: Measurement, Value: -5, Unit: "\u00b0C"}},
+		{"10 km-d\u0259n", Token{Text: "10 km-d\u0259n", Start: 0, End: 10, Type: Measurement, Value: 10, Unit: "km"}},
+
+		// -- Plain numbers stay Number --
+		{"5 m\u0259nzil", Token{Text: "5", Start: 0, End: 1, Type: Number}},
+		{"5-d\u0259", Token{Text: "5", Start: 0, End: 1, Type: Number}},
+		{"2024", Token{Text: "2024", Start: 0, End: 4, Type: Number}},
+		{"$", Token{Text: "$", Start: 0, End: 1, Type: Symbol}},
+		{"12.05.2024", Token{Text: "12", Start: 0, End: 2, Type: Number}},
+		{"-3-c\u00fc", Token{Text: "-", Start: 0, End: 1, Type: Punctuation}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got := tk.WordTokens(tt.input)
+			verifyInvariants(t, tt.input, got)
+			if got[0] != tt.want {
+				t.Errorf("WordTokens(%q)[0] = %+v, want %+v", tt.input, got[0], tt.want)
+			}
+		})
+	}
+
+	// Digits inside a longer number are not an amount of their own.
+	for _, tok := range tk.WordTokens("1.2.5%") {
+		if tok.Type == Percent {
+			t.Errorf("WordTokens(%q) has %+v, want no Percent", "1.2.5%", tok)
+		}
+	}
+
+	// The package-level functions keep quantities apart.
+	if got := WordTokens("100 AZN"); len(got) != 3 || got[0].Type != Number {
+		t.Errorf("package WordTokens(%q) = %v, want Number Space Word", "100 AZN", got)
+	}
+}
+
+func ExampleOptions_quantities() {
+	tk := New(Options{Quantities: true})
+	for _, t := range tk.WordTokens("Qiym\u0259t 15% artaraq 100 AZN oldu") {
+		if t.Type != Word && t.Type != Space {
+			fmt.Printf("%s %q %v %s\n", t.Type, t.Text, t.Value, t.Unit)
+		}
+	}
+	// Output:
+	// Percent "15%" 15 %
+	// Money "100 AZN" 100 AZN
+}
+
 // ---------------------------------------------------------------------------
 // Concurrent safety
 // ---------------------------------------------------------------------------